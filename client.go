@@ -2,14 +2,19 @@ package main
 
 import (
 	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"net/http"
+	"strings"
 	// "log"
 	httpdigest "github.com/ryanjdew/http-digest-auth-client"
 )
 
 type MongoatlasClient struct {
-	Username string
-	ApiKey   string
+	Username       string
+	ApiKey         string
+	DefaultGroupId string
 }
 
 func (c *MongoatlasClient) Get(endpoint string) (*http.Response, error) {
@@ -25,6 +30,49 @@ func (c *MongoatlasClient) Get(endpoint string) (*http.Response, error) {
 	return client.Do(req)
 }
 
+// PaginatedResults is the envelope Atlas wraps around every list endpoint.
+type PaginatedResults struct {
+	Results    []json.RawMessage `json:"results"`
+	TotalCount int               `json:"totalCount"`
+}
+
+// GetAll walks the pages of a list endpoint and returns every result, still
+// encoded, for the caller to decode into its own type.
+func (c *MongoatlasClient) GetAll(endpoint string) ([]json.RawMessage, error) {
+	separator := "?"
+	if strings.Contains(endpoint, "?") {
+		separator = "&"
+	}
+
+	var results []json.RawMessage
+	for pageNum := 1; ; pageNum++ {
+		resp, err := c.Get(fmt.Sprintf("%s%sitemsPerPage=500&pageNum=%d", endpoint, separator, pageNum))
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode != 200 {
+			body, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			return nil, fmt.Errorf("Failed to list %s. Got the following response body %s", endpoint, string(body))
+		}
+
+		var page PaginatedResults
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, page.Results...)
+		if len(page.Results) == 0 || len(results) >= page.TotalCount {
+			return results, nil
+		}
+	}
+}
+
 func (c *MongoatlasClient) Post(endpoint string, jsonpayload *bytes.Buffer) (*http.Response, error) {
 	dh := &httpdigest.DigestHeaders{}
 	dh, err := dh.Auth(c.Username, c.ApiKey, "https://cloud.mongodb.com/api/atlas/v1.0/")
@@ -51,6 +99,19 @@ func (c *MongoatlasClient) Patch(endpoint string, jsonpayload *bytes.Buffer) (*h
 	return client.Do(req)
 }
 
+func (c *MongoatlasClient) Put(endpoint string, jsonpayload *bytes.Buffer) (*http.Response, error) {
+	dh := &httpdigest.DigestHeaders{}
+	dh, err := dh.Auth(c.Username, c.ApiKey, "https://cloud.mongodb.com/api/atlas/v1.0/")
+	client := &http.Client{}
+	req, err := http.NewRequest("PUT", "https://cloud.mongodb.com/api/atlas/v1.0/"+endpoint, jsonpayload)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("content-type", "application/json")
+	dh.ApplyAuth(req)
+	return client.Do(req)
+}
+
 func (c *MongoatlasClient) Delete(endpoint string) (*http.Response, error) {
 	dh := &httpdigest.DigestHeaders{}
 	dh, err := dh.Auth(c.Username, c.ApiKey, "https://cloud.mongodb.com/api/atlas/v1.0/")
@@ -64,17 +125,6 @@ func (c *MongoatlasClient) Delete(endpoint string) (*http.Response, error) {
 }
 
 /*
-func (c *MongoatlasClient) Put(endpoint string, jsonpayload *bytes.Buffer) (*http.Response, error) {
-    client := &http.Client{}
-    req, err := http.NewRequest("PUT", "https://cloud.mongodb.com/api/atlas/v1.0/"+endpoint, jsonpayload)
-    if err != nil {
-        return nil, err
-    }
-    req.SetBasicAuth(c.Username, c.ApiKey)
-    req.Header.Add("content-type", "application/json")
-    return client.Do(req)
-}
-
 func (c *MongoatlasClient) PutOnly(endpoint string) (*http.Response, error) {
     client := &http.Client{}
     req, err := http.NewRequest("PUT", "https://cloud.mongodb.com/api/atlas/v1.0/"+endpoint, nil)
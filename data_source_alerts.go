@@ -0,0 +1,198 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"log"
+)
+
+type Alert struct {
+	Id                     string        `json:"id,omitempty"`
+	GroupId                string        `json:"groupId,omitempty"`
+	AlertConfigId          string        `json:"alertConfigId,omitempty"`
+	EventTypeName          string        `json:"eventTypeName,omitempty"`
+	TypeName               string        `json:"typeName,omitempty"`
+	Status                 string        `json:"status,omitempty"`
+	Created                string        `json:"created,omitempty"`
+	Updated                string        `json:"updated,omitempty"`
+	Resolved               string        `json:"resolved,omitempty"`
+	LastNotified           string        `json:"lastNotified,omitempty"`
+	AcknowledgedUntil      string        `json:"acknowledgedUntil,omitempty"`
+	AcknowledgementComment string        `json:"acknowledgementComment,omitempty"`
+	AcknowledgingUsername  string        `json:"acknowledgingUsername,omitempty"`
+	UnacknowledgeAlert     *bool         `json:"unacknowledgeAlert,omitempty"`
+	ClusterName            string        `json:"clusterName,omitempty"`
+	HostnameAndPort        string        `json:"hostnameAndPort,omitempty"`
+	ReplicaSetName         string        `json:"replicaSetName,omitempty"`
+	MetricName             string        `json:"metricName,omitempty"`
+	CurrentValue           *CurrentValue `json:"currentValue,omitempty"`
+}
+
+type CurrentValue struct {
+	Number float64 `json:"number,omitempty"`
+	Units  string  `json:"units,omitempty"`
+}
+
+func dataSourceAlerts() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlertsRead,
+		Schema: map[string]*schema.Schema{
+			"groupId": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAlertStatus,
+			},
+			"eventTypeName": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"results": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"alertConfigId": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"eventTypeName": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"typeName": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resolved": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"lastNotified": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"acknowledgedUntil": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"acknowledgementComment": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"acknowledgingUsername": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"clusterName": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hostnameAndPort": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"replicaSetName": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"metricName": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"currentValue": &schema.Schema{
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"currentValueUnits": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlertsRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	endpoint := fmt.Sprintf("groups/%s/alerts", d.Get("groupId").(string))
+	if status, ok := d.GetOk("status"); ok {
+		endpoint = fmt.Sprintf("%s?status=%s", endpoint, status.(string))
+	}
+
+	results, err := client.GetAll(endpoint)
+	if err != nil {
+		return err
+	}
+	log.Printf("Received %d alerts \n", len(results))
+
+	// Atlas cannot filter alerts by event type, so it is done here
+	eventTypeName := d.Get("eventTypeName").(string)
+
+	var s []map[string]interface{}
+	for _, result := range results {
+		var alert Alert
+		if err := json.Unmarshal(result, &alert); err != nil {
+			return err
+		}
+
+		if eventTypeName != "" && alert.EventTypeName != eventTypeName {
+			continue
+		}
+
+		mapping := map[string]interface{}{
+			"id":                     alert.Id,
+			"alertConfigId":          alert.AlertConfigId,
+			"eventTypeName":          alert.EventTypeName,
+			"typeName":               alert.TypeName,
+			"status":                 alert.Status,
+			"created":                alert.Created,
+			"updated":                alert.Updated,
+			"resolved":               alert.Resolved,
+			"lastNotified":           alert.LastNotified,
+			"acknowledgedUntil":      alert.AcknowledgedUntil,
+			"acknowledgementComment": alert.AcknowledgementComment,
+			"acknowledgingUsername":  alert.AcknowledgingUsername,
+			"clusterName":            alert.ClusterName,
+			"hostnameAndPort":        alert.HostnameAndPort,
+			"replicaSetName":         alert.ReplicaSetName,
+			"metricName":             alert.MetricName,
+		}
+		if alert.CurrentValue != nil {
+			mapping["currentValue"] = alert.CurrentValue.Number
+			mapping["currentValueUnits"] = alert.CurrentValue.Units
+		}
+
+		s = append(s, mapping)
+	}
+
+	d.SetId(fmt.Sprintf("%s-%s-%s", d.Get("groupId").(string), d.Get("status").(string), eventTypeName))
+	if err := d.Set("results", s); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccMongoatlasDataSourceAlerts_basic(t *testing.T) {
+	testGroupId := os.Getenv("MONGOATLAS_GROUPID")
+
+	testAccMongoatlasDataSourceAlertsConfig := fmt.Sprintf(
+		`data "mongoatlas_alerts" "acceptancetest_alerts" {
+			groupId = "%s"
+			status = "OPEN"
+		}
+	`, testGroupId)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccMongoatlasDataSourceAlertsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.mongoatlas_alerts.acceptancetest_alerts", "results.#"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,249 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"io/ioutil"
+	"log"
+)
+
+func dataSourceCluster() *schema.Resource {
+	clusterSchema := dataSourceClusterAttributes()
+	clusterSchema["groupId"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	clusterSchema["name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceClusterRead,
+		Schema: clusterSchema,
+	}
+}
+
+func dataSourceClusters() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceClustersRead,
+		Schema: map[string]*schema.Schema{
+			"groupId": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"results": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: dataSourceClusterAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// dataSourceClusterAttributes returns the computed attributes shared by the
+// mongoatlas_cluster data source and every element of mongoatlas_clusters.
+func dataSourceClusterAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"backupEnabled": &schema.Schema{
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"diskSizeGB": &schema.Schema{
+			Type:     schema.TypeFloat,
+			Computed: true,
+		},
+		"mongoDBMajorVersion": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"mongoDBVersion": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"mongoURI": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"mongoURIUpdated": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"mongoURIWithOptions": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"srvAddress": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"connectionStringStandard": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"connectionStringStandardSrv": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"connectionStringPrivate": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"connectionStringPrivateSrv": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"numShards": &schema.Schema{
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"replicationFactor": &schema.Schema{
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"providerName": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"regionName": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"instanceSizeName": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"diskIOPS": &schema.Schema{
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"encryptEBSVolume": &schema.Schema{
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"stateName": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"encryptionAtRestProvider": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+// flattenCluster maps a Cluster returned by Atlas onto the attributes
+// declared in dataSourceClusterAttributes.
+func flattenCluster(cluster *Cluster) map[string]interface{} {
+	result := map[string]interface{}{
+		"name":                     cluster.Name,
+		"diskSizeGB":               cluster.DiskSizeGB,
+		"mongoDBMajorVersion":      cluster.MongoDBMajorVersion,
+		"mongoDBVersion":           cluster.MongoDBVersion,
+		"mongoURI":                 cluster.MongoURI,
+		"mongoURIUpdated":          cluster.MongoURIUpdated,
+		"mongoURIWithOptions":      cluster.MongoURIWithOptions,
+		"srvAddress":               cluster.SrvAddress,
+		"numShards":                cluster.NumShards,
+		"replicationFactor":        cluster.ReplicationFactor,
+		"stateName":                cluster.StateName,
+		"encryptionAtRestProvider": cluster.EncryptionAtRestProvider,
+	}
+
+	if cluster.BackupEnabled != nil {
+		result["backupEnabled"] = *cluster.BackupEnabled
+	}
+
+	if cluster.ConnectionStrings != nil {
+		result["connectionStringStandard"] = cluster.ConnectionStrings.Standard
+		result["connectionStringStandardSrv"] = cluster.ConnectionStrings.StandardSrv
+		result["connectionStringPrivate"] = cluster.ConnectionStrings.Private
+		result["connectionStringPrivateSrv"] = cluster.ConnectionStrings.PrivateSrv
+	}
+
+	if cluster.ProviderSettings != nil {
+		result["providerName"] = cluster.ProviderSettings.ProviderName
+		result["regionName"] = cluster.ProviderSettings.RegionName
+		result["instanceSizeName"] = cluster.ProviderSettings.InstanceSizeName
+		result["diskIOPS"] = cluster.ProviderSettings.DiskIOPS
+		if cluster.ProviderSettings.EncryptEBSVolume != nil {
+			result["encryptEBSVolume"] = *cluster.ProviderSettings.EncryptEBSVolume
+		}
+	}
+
+	return result
+}
+
+func dataSourceClusterRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	cluster_req, err := client.Get(fmt.Sprintf("groups/%s/clusters/%s",
+		d.Get("groupId").(string),
+		d.Get("name").(string),
+	))
+
+	if err != nil {
+		return err
+	}
+
+	if cluster_req.StatusCode != 200 {
+		body, err := ioutil.ReadAll(cluster_req.Body)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("Failed to read cluster %s. Got the following response body %s", d.Get("name").(string), string(body))
+	}
+
+	var cluster Cluster
+
+	decoder := json.NewDecoder(cluster_req.Body)
+	err = decoder.Decode(&cluster)
+	if err != nil {
+		return err
+	}
+	log.Printf("Received %+v \n", cluster)
+
+	d.SetId(cluster.Name)
+	for k, v := range flattenCluster(&cluster) {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func dataSourceClustersRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	results, err := client.GetAll(fmt.Sprintf("groups/%s/clusters",
+		d.Get("groupId").(string),
+	))
+	if err != nil {
+		return err
+	}
+	log.Printf("Received %d clusters \n", len(results))
+
+	var s []map[string]interface{}
+	for _, raw := range results {
+		var cluster Cluster
+		if err := json.Unmarshal(raw, &cluster); err != nil {
+			return err
+		}
+		s = append(s, flattenCluster(&cluster))
+	}
+
+	d.SetId(d.Get("groupId").(string))
+	if err := d.Set("results", s); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccMongoatlasDataSourceCluster_basic(t *testing.T) {
+	testGroupId := os.Getenv("MONGOATLAS_GROUPID")
+
+	testAccMongoatlasDataSourceClusterConfig := fmt.Sprintf(
+		`resource "mongoatlas_cluster" "acceptancetest_cluster" {
+	    	groupId = "%s"
+	    	name = "terratest4"
+		    backupEnabled = false
+		    instanceSizeName = "M10"
+		    diskSizeGB = "10"
+		    mongoDBMajorVersion = "3.6"
+		    providerName = "AWS"
+		    regionName = "EU_WEST_1"
+		}
+
+		data "mongoatlas_cluster" "acceptancetest_cluster" {
+	    	groupId = "${mongoatlas_cluster.acceptancetest_cluster.groupId}"
+	    	name = "${mongoatlas_cluster.acceptancetest_cluster.name}"
+		}
+
+		data "mongoatlas_clusters" "acceptancetest_clusters" {
+	    	groupId = "${mongoatlas_cluster.acceptancetest_cluster.groupId}"
+		}
+	`, testGroupId)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoatlasClusterDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccMongoatlasDataSourceClusterConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.mongoatlas_cluster.acceptancetest_cluster", "name", "terratest4"),
+					resource.TestCheckResourceAttr(
+						"data.mongoatlas_cluster.acceptancetest_cluster", "providerName", "AWS"),
+					resource.TestCheckResourceAttrSet(
+						"data.mongoatlas_cluster.acceptancetest_cluster", "mongoURI"),
+					resource.TestCheckResourceAttrSet(
+						"data.mongoatlas_clusters.acceptancetest_clusters", "results.#"),
+				),
+			},
+		},
+	})
+}
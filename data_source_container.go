@@ -0,0 +1,184 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"log"
+	"strings"
+)
+
+func dataSourceContainer() *schema.Resource {
+	containerSchema := dataSourceContainerAttributes()
+	containerSchema["groupId"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	containerSchema["providerName"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  "AWS",
+	}
+	containerSchema["regionName"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+	delete(containerSchema, "id")
+
+	return &schema.Resource{
+		Read:   dataSourceContainerRead,
+		Schema: containerSchema,
+	}
+}
+
+func dataSourceContainers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceContainersRead,
+		Schema: map[string]*schema.Schema{
+			"groupId": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"providerName": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "AWS",
+			},
+			"regionName": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"results": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: dataSourceContainerAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// dataSourceContainerAttributes returns the computed attributes shared by the
+// mongoatlas_container data source and every element of mongoatlas_containers.
+func dataSourceContainerAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"atlasCidrBlock": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"providerName": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"regionName": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"vpcId": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"isProvisioned": &schema.Schema{
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+	}
+}
+
+func flattenContainer(container *Container) map[string]interface{} {
+	return map[string]interface{}{
+		"id":             container.Id,
+		"atlasCidrBlock": container.AtlasCidrBlock,
+		"providerName":   container.ProviderName,
+		"regionName":     container.RegionName,
+		"vpcId":          container.VpcId,
+		"isProvisioned":  container.IsProvisioned,
+	}
+}
+
+// listContainers returns the containers of one cloud provider, optionally
+// narrowed down to a region. Atlas only lists AWS containers unless asked.
+func listContainers(client *MongoatlasClient, groupId string, providerName string, regionName string) ([]Container, error) {
+	results, err := client.GetAll(fmt.Sprintf("groups/%s/containers?providerName=%s", groupId, providerName))
+	if err != nil {
+		return nil, err
+	}
+
+	containers := []Container{}
+	for _, raw := range results {
+		var container Container
+		if err := json.Unmarshal(raw, &container); err != nil {
+			return nil, err
+		}
+
+		if regionName != "" && !strings.EqualFold(container.RegionName, regionName) {
+			continue
+		}
+		containers = append(containers, container)
+	}
+
+	log.Printf("Received %d containers \n", len(containers))
+
+	return containers, nil
+}
+
+func dataSourceContainerRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	containers, err := listContainers(client,
+		d.Get("groupId").(string),
+		d.Get("providerName").(string),
+		d.Get("regionName").(string),
+	)
+	if err != nil {
+		return err
+	}
+
+	if len(containers) != 1 {
+		return fmt.Errorf("Expected exactly one %s container, found %d. Narrow the search down with regionName",
+			d.Get("providerName").(string), len(containers))
+	}
+
+	container := flattenContainer(&containers[0])
+	delete(container, "id")
+
+	d.SetId(containers[0].Id)
+	for k, v := range container {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func dataSourceContainersRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	containers, err := listContainers(client,
+		d.Get("groupId").(string),
+		d.Get("providerName").(string),
+		d.Get("regionName").(string),
+	)
+	if err != nil {
+		return err
+	}
+
+	var s []map[string]interface{}
+	for i := range containers {
+		s = append(s, flattenContainer(&containers[i]))
+	}
+
+	d.SetId(fmt.Sprintf("%s-%s", d.Get("groupId").(string), d.Get("providerName").(string)))
+	if err := d.Set("results", s); err != nil {
+		return err
+	}
+
+	return nil
+}
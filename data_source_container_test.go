@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccMongoatlasDataSourceContainer_basic(t *testing.T) {
+	testGroupId := os.Getenv("MONGOATLAS_GROUPID")
+
+	testAccMongoatlasDataSourceContainerConfig := fmt.Sprintf(
+		`resource "mongoatlas_container" "acceptancetest_container" {
+			groupId = "%s"
+			atlasCidrBlock = "192.168.240.0/21"
+			providerName = "AWS"
+			regionName = "US_WEST_2"
+		}
+
+		data "mongoatlas_container" "acceptancetest_container" {
+			groupId = "${mongoatlas_container.acceptancetest_container.groupId}"
+			providerName = "AWS"
+			regionName = "${mongoatlas_container.acceptancetest_container.regionName}"
+		}
+
+		data "mongoatlas_containers" "acceptancetest_containers" {
+			groupId = "${mongoatlas_container.acceptancetest_container.groupId}"
+			providerName = "AWS"
+		}
+	`, testGroupId)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoatlasContainerDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccMongoatlasDataSourceContainerConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.mongoatlas_container.acceptancetest_container", "atlasCidrBlock", "192.168.240.0/21"),
+					resource.TestCheckResourceAttr(
+						"data.mongoatlas_container.acceptancetest_container", "isProvisioned", "false"),
+					resource.TestCheckResourceAttrSet(
+						"data.mongoatlas_container.acceptancetest_container", "vpcId"),
+					resource.TestCheckResourceAttrSet(
+						"data.mongoatlas_containers.acceptancetest_containers", "results.#"),
+				),
+			},
+		},
+	})
+}
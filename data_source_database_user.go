@@ -0,0 +1,238 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"io/ioutil"
+	"log"
+)
+
+func dataSourceDatabaseUser() *schema.Resource {
+	databaseUserSchema := dataSourceDatabaseUserAttributes()
+	databaseUserSchema["groupId"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	databaseUserSchema["username"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	// users authenticated outside of Atlas, X.509, LDAP users and AWS IAM users,
+	// are looked up with databaseName = "$external"
+	databaseUserSchema["databaseName"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  "admin",
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceDatabaseUserRead,
+		Schema: databaseUserSchema,
+	}
+}
+
+func dataSourceDatabaseUsers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDatabaseUsersRead,
+		Schema: map[string]*schema.Schema{
+			"groupId": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"results": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: dataSourceDatabaseUserAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// dataSourceDatabaseUserAttributes returns the computed attributes shared by
+// the mongoatlas_database_user data source and every element of
+// mongoatlas_database_users. Atlas never returns passwords, neither do these.
+func dataSourceDatabaseUserAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"username": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"databaseName": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"roles": &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"databaseName": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"roleName": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"scopes": &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"type": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"labels": &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"value": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"x509Type": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"ldapAuthType": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"awsIAMType": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+// flattenDatabaseUser maps a DatabaseUser returned by Atlas onto the
+// attributes declared in dataSourceDatabaseUserAttributes.
+func flattenDatabaseUser(databaseuser *DatabaseUser) map[string]interface{} {
+	result := map[string]interface{}{
+		"username":     databaseuser.Username,
+		"databaseName": databaseuser.DatabaseName,
+		"x509Type":     databaseuser.X509Type,
+		"ldapAuthType": databaseuser.LdapAuthType,
+		"awsIAMType":   databaseuser.AwsIAMType,
+	}
+
+	roles := []map[string]interface{}{}
+	if databaseuser.Roles != nil {
+		for _, t := range *databaseuser.Roles {
+			roles = append(roles, map[string]interface{}{
+				"databaseName": t.DatabaseName,
+				"roleName":     t.RoleName,
+			})
+		}
+	}
+	result["roles"] = roles
+
+	scopes := []map[string]interface{}{}
+	if databaseuser.Scopes != nil {
+		for _, t := range *databaseuser.Scopes {
+			scopes = append(scopes, map[string]interface{}{
+				"name": t.Name,
+				"type": t.Type,
+			})
+		}
+	}
+	result["scopes"] = scopes
+
+	labels := []map[string]interface{}{}
+	if databaseuser.Labels != nil {
+		for _, t := range *databaseuser.Labels {
+			labels = append(labels, map[string]interface{}{
+				"key":   t.Key,
+				"value": t.Value,
+			})
+		}
+	}
+	result["labels"] = labels
+
+	return result
+}
+
+func dataSourceDatabaseUserRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	databaseuser_req, err := client.Get(databaseUserEndpoint(d))
+
+	if err != nil {
+		return err
+	}
+
+	if databaseuser_req.StatusCode != 200 {
+		body, err := ioutil.ReadAll(databaseuser_req.Body)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("Failed to read database user %s. Got the following response body %s", d.Get("username").(string), string(body))
+	}
+
+	var databaseuser DatabaseUser
+
+	decoder := json.NewDecoder(databaseuser_req.Body)
+	err = decoder.Decode(&databaseuser)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s-%s", databaseuser.DatabaseName, databaseuser.Username))
+	for k, v := range flattenDatabaseUser(&databaseuser) {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func dataSourceDatabaseUsersRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	results, err := client.GetAll(fmt.Sprintf("groups/%s/databaseUsers",
+		d.Get("groupId").(string),
+	))
+	if err != nil {
+		return err
+	}
+	log.Printf("Received %d database users \n", len(results))
+
+	var s []map[string]interface{}
+	for _, raw := range results {
+		var databaseuser DatabaseUser
+		if err := json.Unmarshal(raw, &databaseuser); err != nil {
+			return err
+		}
+		s = append(s, flattenDatabaseUser(&databaseuser))
+	}
+
+	d.SetId(d.Get("groupId").(string))
+	if err := d.Set("results", s); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccMongoatlasDataSourceDatabaseUser_basic(t *testing.T) {
+	testGroupId := os.Getenv("MONGOATLAS_GROUPID")
+
+	testAccMongoatlasDataSourceDatabaseUserConfig := fmt.Sprintf(
+		`resource "mongoatlas_database_user" "acceptancetest_databaseuser" {
+			databaseName = "admin"
+			username = "acctest"
+			password = "test"
+			roles = [
+				{
+					databaseName = "admin"
+					roleName = "backup"
+				}
+			]
+			groupId = "%s"
+		}
+
+		data "mongoatlas_database_user" "acceptancetest_databaseuser" {
+			groupId = "${mongoatlas_database_user.acceptancetest_databaseuser.groupId}"
+			username = "${mongoatlas_database_user.acceptancetest_databaseuser.username}"
+		}
+
+		data "mongoatlas_database_users" "acceptancetest_databaseusers" {
+			groupId = "${mongoatlas_database_user.acceptancetest_databaseuser.groupId}"
+		}
+	`, testGroupId)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoatlasDatabaseUserDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccMongoatlasDataSourceDatabaseUserConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.mongoatlas_database_user.acceptancetest_databaseuser", "databaseName", "admin"),
+					resource.TestCheckResourceAttr(
+						"data.mongoatlas_database_user.acceptancetest_databaseuser", "roles.0.roleName", "backup"),
+					resource.TestCheckNoResourceAttr(
+						"data.mongoatlas_database_user.acceptancetest_databaseuser", "password"),
+					resource.TestCheckResourceAttrSet(
+						"data.mongoatlas_database_users.acceptancetest_databaseusers", "results.#"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"io/ioutil"
+	"log"
+	"net/url"
+)
+
+func dataSourceProject() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceProjectRead,
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"orgId": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"clusterCount": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"created": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceProjectRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	project_req, err := client.Get(fmt.Sprintf("groups/byName/%s",
+		url.PathEscape(d.Get("name").(string)),
+	))
+	if err != nil {
+		return err
+	}
+
+	if project_req.StatusCode != 200 {
+		body, err := ioutil.ReadAll(project_req.Body)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("Failed to find project %s. Got the following response body %s", d.Get("name").(string), string(body))
+	}
+
+	var project Project
+
+	decoder := json.NewDecoder(project_req.Body)
+	err = decoder.Decode(&project)
+	if err != nil {
+		return err
+	}
+	log.Printf("Received %+v \n", project)
+
+	d.SetId(project.Id)
+	d.Set("name", project.Name)
+	d.Set("orgId", project.OrgId)
+	d.Set("clusterCount", project.ClusterCount)
+	d.Set("created", project.Created)
+
+	return nil
+}
@@ -1,12 +1,13 @@
 package main
 
 import (
+	"fmt"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
 func Provider() terraform.ResourceProvider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"username": {
 				Required:    true,
@@ -18,23 +19,98 @@ func Provider() terraform.ResourceProvider {
 				Type:        schema.TypeString,
 				DefaultFunc: schema.EnvDefaultFunc("MONGOATLAS_APIKEY", nil),
 			},
+			"default_group_id": {
+				Optional:    true,
+				Type:        schema.TypeString,
+				DefaultFunc: schema.EnvDefaultFunc("MONGOATLAS_DEFAULT_GROUPID", nil),
+			},
 		},
 		ConfigureFunc: providerConfigure,
 		ResourcesMap: map[string]*schema.Resource{
-			"mongoatlas_vpc_peering":       resourceVpcPeering(),
-			"mongoatlas_cluster":           resourceCluster(),
-			"mongoatlas_database_user":     resourceDatabaseUser(),
-			"mongoatlas_groupip_whitelist": resourceGroupipWhitelist(),
-			"mongoatlas_container":         resourceContainer(),
+			"mongoatlas_vpc_peering":                         resourceVpcPeering(),
+			"mongoatlas_cluster":                             resourceCluster(),
+			"mongoatlas_database_user":                       resourceDatabaseUser(),
+			"mongoatlas_groupip_whitelist":                   resourceGroupipWhitelist(),
+			"mongoatlas_container":                           resourceContainer(),
+			"mongoatlas_project":                             resourceProject(),
+			"mongoatlas_alert_configuration":                 resourceAlertConfiguration(),
+			"mongoatlas_alert_acknowledgement":               resourceAlertAcknowledgement(),
+			"mongoatlas_third_party_integration":             resourceThirdPartyIntegration(),
+			"mongoatlas_maintenance_window":                  resourceMaintenanceWindow(),
+			"mongoatlas_auditing":                            resourceAuditing(),
+			"mongoatlas_encryption_at_rest":                  resourceEncryptionAtRest(),
+			"mongoatlas_cloud_provider_access":               resourceCloudProviderAccess(),
+			"mongoatlas_cloud_provider_access_authorization": resourceCloudProviderAccessAuthorization(),
+			"mongoatlas_private_endpoint":                    resourcePrivateEndpoint(),
+			"mongoatlas_private_endpoint_interface_link":     resourcePrivateEndpointInterfaceLink(),
+			"mongoatlas_project_access_list":                 resourceProjectAccessList(),
+			"mongoatlas_x509_certificate":                    resourceX509Certificate(),
+			"mongoatlas_ldap_configuration":                  resourceLdapConfiguration(),
+			"mongoatlas_ldap_verify":                         resourceLdapVerify(),
 		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"mongoatlas_cluster":        dataSourceCluster(),
+			"mongoatlas_clusters":       dataSourceClusters(),
+			"mongoatlas_project":        dataSourceProject(),
+			"mongoatlas_alerts":         dataSourceAlerts(),
+			"mongoatlas_container":      dataSourceContainer(),
+			"mongoatlas_containers":     dataSourceContainers(),
+			"mongoatlas_database_user":  dataSourceDatabaseUser(),
+			"mongoatlas_database_users": dataSourceDatabaseUsers(),
+		},
+	}
+
+	// groupId is resolved against this provider instance, so aliased providers
+	// each fall back to their own default_group_id
+	for _, resource := range provider.ResourcesMap {
+		setDefaultGroupId(provider, resource)
+	}
+	for _, resource := range provider.DataSourcesMap {
+		setDefaultGroupId(provider, resource)
 	}
+
+	return provider
 }
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	client := &MongoatlasClient{
-		Username: d.Get("username").(string),
-		ApiKey:   d.Get("apiKey").(string),
+		Username:       d.Get("username").(string),
+		ApiKey:         d.Get("apiKey").(string),
+		DefaultGroupId: d.Get("default_group_id").(string),
 	}
 
 	return client, nil
 }
+
+// groupIdSchema is the groupId argument shared by every project scoped
+// resource. When omitted it is filled in from default_group_id and written
+// to the state like any other value, so changing the default replaces the
+// resources relying on it.
+func groupIdSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	}
+}
+
+func setDefaultGroupId(provider *schema.Provider, resource *schema.Resource) {
+	groupId, ok := resource.Schema["groupId"]
+	if !ok || groupId.Required {
+		return
+	}
+
+	groupId.DefaultFunc = func() (interface{}, error) {
+		// the provider is not configured yet while terraform validates
+		meta := provider.Meta()
+		if meta == nil {
+			return nil, nil
+		}
+
+		client := meta.(*MongoatlasClient)
+		if client.DefaultGroupId == "" {
+			return nil, fmt.Errorf("groupId must be set on the resource or through default_group_id on the provider")
+		}
+		return client.DefaultGroupId, nil
+	}
+}
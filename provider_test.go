@@ -1,6 +1,7 @@
 package main
 
 import (
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	"os"
@@ -46,3 +47,34 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("MONGOATLAS_AWSACCOUNTID must be set for acceptance tests")
 	}
 }
+
+// testResourceDiff plans r against the raw configuration, as CustomizeDiff
+// sees it. Values set to config.UnknownVariableValue are unknown until apply.
+func testResourceDiff(r *schema.Resource, raw map[string]interface{}) error {
+	c, err := config.NewRawConfig(raw)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.Diff(nil, terraform.NewResourceConfig(c), nil)
+	return err
+}
+
+func TestProvider_defaultGroupId(t *testing.T) {
+	provider := Provider().(*schema.Provider)
+	groupId := provider.ResourcesMap["mongoatlas_cluster"].Schema["groupId"]
+
+	if v, err := groupId.DefaultFunc(); v != nil || err != nil {
+		t.Fatalf("Expected no default before configure, got %+v, %+v", v, err)
+	}
+
+	provider.SetMeta(&MongoatlasClient{})
+	if _, err := groupId.DefaultFunc(); err == nil {
+		t.Fatalf("Expected an error when neither groupId nor default_group_id is set")
+	}
+
+	provider.SetMeta(&MongoatlasClient{DefaultGroupId: "0000000000000000000000"})
+	if v, err := groupId.DefaultFunc(); v != "0000000000000000000000" || err != nil {
+		t.Fatalf("Expected default_group_id to be used, got %+v, %+v", v, err)
+	}
+}
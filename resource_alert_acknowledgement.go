@@ -0,0 +1,171 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"io/ioutil"
+	"log"
+	"time"
+)
+
+func resourceAlertAcknowledgement() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlertAcknowledgementCreate,
+		Update: resourceAlertAcknowledgementUpdate,
+		Read:   resourceAlertAcknowledgementRead,
+		Delete: resourceAlertAcknowledgementDelete,
+		Schema: map[string]*schema.Schema{
+			"groupId": groupIdSchema(),
+			"alertId": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"acknowledgedUntil": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentTime,
+			},
+			"acknowledgementComment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"acknowledgingUsername": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// suppressEquivalentTime ignores timestamps that only differ in their offset,
+// Atlas returns every date in UTC.
+func suppressEquivalentTime(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
+
+// patchAlert is shared by create, update and delete since acknowledging,
+// changing an acknowledgement and unacknowledging are all the same PATCH.
+func patchAlert(d *schema.ResourceData, m interface{}, alert *Alert) error {
+	client := m.(*MongoatlasClient)
+
+	var jsonbuffer []byte
+
+	jsonpayload := bytes.NewBuffer(jsonbuffer)
+	enc := json.NewEncoder(jsonpayload)
+	enc.Encode(alert)
+
+	log.Printf("Sending %s \n", jsonpayload)
+
+	alert_req, err := client.Patch(fmt.Sprintf("groups/%s/alerts/%s",
+		d.Get("groupId").(string),
+		d.Get("alertId").(string),
+	), jsonpayload)
+	if err != nil {
+		return err
+	}
+
+	if alert_req.StatusCode != 200 {
+		body, err := ioutil.ReadAll(alert_req.Body)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("Failed to patch alert %s: %s", d.Get("alertId").(string), string(body))
+	}
+
+	return nil
+}
+
+func resourceAlertAcknowledgementCreate(d *schema.ResourceData, m interface{}) error {
+	alert := &Alert{
+		AcknowledgedUntil:      d.Get("acknowledgedUntil").(string),
+		AcknowledgementComment: d.Get("acknowledgementComment").(string),
+	}
+
+	if err := patchAlert(d, m, alert); err != nil {
+		return err
+	}
+
+	d.SetId(d.Get("alertId").(string))
+
+	return resourceAlertAcknowledgementRead(d, m)
+}
+
+func resourceAlertAcknowledgementRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	alert_req, err := client.Get(fmt.Sprintf("groups/%s/alerts/%s",
+		d.Get("groupId").(string),
+		d.Get("alertId").(string),
+	))
+	if err != nil {
+		return err
+	}
+
+	if alert_req.StatusCode == 404 {
+		log.Printf("[DEBUG] alert %s no longer exist, so we'll drop it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if alert_req.StatusCode != 200 {
+		body, err := ioutil.ReadAll(alert_req.Body)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("Failed to read alert. Got the following response body %s", string(body))
+	}
+
+	var alert Alert
+
+	decoder := json.NewDecoder(alert_req.Body)
+	err = decoder.Decode(&alert)
+	if err != nil {
+		return err
+	}
+	log.Printf("Received %+v \n", alert)
+
+	// an acknowledgement that expired or was removed in the UI is gone
+	if alert.AcknowledgedUntil == "" {
+		log.Printf("[DEBUG] alert %s is no longer acknowledged, so we'll drop it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("acknowledgedUntil", alert.AcknowledgedUntil)
+	d.Set("acknowledgementComment", alert.AcknowledgementComment)
+	d.Set("acknowledgingUsername", alert.AcknowledgingUsername)
+
+	return nil
+}
+
+func resourceAlertAcknowledgementUpdate(d *schema.ResourceData, m interface{}) error {
+	alert := &Alert{
+		AcknowledgedUntil:      d.Get("acknowledgedUntil").(string),
+		AcknowledgementComment: d.Get("acknowledgementComment").(string),
+	}
+
+	if err := patchAlert(d, m, alert); err != nil {
+		return err
+	}
+
+	return resourceAlertAcknowledgementRead(d, m)
+}
+
+func resourceAlertAcknowledgementDelete(d *schema.ResourceData, m interface{}) error {
+	unacknowledgeAlert := new(bool)
+	*unacknowledgeAlert = true
+
+	return patchAlert(d, m, &Alert{UnacknowledgeAlert: unacknowledgeAlert})
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccMongoatlasAlertAcknowledgement_basic(t *testing.T) {
+	var alert Alert
+
+	testGroupId := os.Getenv("MONGOATLAS_GROUPID")
+	testAlertId := os.Getenv("MONGOATLAS_ALERTID")
+
+	testAccMongoatlasAlertAcknowledgementConfig := fmt.Sprintf(
+		`resource "mongoatlas_alert_acknowledgement" "acceptancetest_alertacknowledgement" {
+			groupId = "%s"
+			alertId = "%s"
+			acknowledgedUntil = "2030-01-01T00:00:00Z"
+			acknowledgementComment = "terraform test"
+		}
+	`, testGroupId, testAlertId)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if testAlertId == "" {
+				t.Fatal("MONGOATLAS_ALERTID must be set to an open alert for acknowledgement acceptance tests")
+			}
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoatlasAlertAcknowledgementDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccMongoatlasAlertAcknowledgementConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoatlasAlertAcknowledgementExists("mongoatlas_alert_acknowledgement.acceptancetest_alertacknowledgement", &alert),
+					resource.TestCheckResourceAttr(
+						"mongoatlas_alert_acknowledgement.acceptancetest_alertacknowledgement", "acknowledgementComment", "terraform test"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccCheckMongoatlasAlertAcknowledgementDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*MongoatlasClient)
+	rs, ok := s.RootModule().Resources["mongoatlas_alert_acknowledgement.acceptancetest_alertacknowledgement"]
+	if !ok {
+		return fmt.Errorf("Not found %s", "mongoatlas_alert_acknowledgement.acceptancetest_alertacknowledgement")
+	}
+
+	response, err := client.Get(fmt.Sprintf("groups/%s/alerts/%s", rs.Primary.Attributes["groupId"], rs.Primary.ID))
+
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode == 200 {
+		var alert Alert
+		if err := json.NewDecoder(response.Body).Decode(&alert); err != nil {
+			return err
+		}
+		if alert.AcknowledgedUntil != "" {
+			return fmt.Errorf("Alert is still acknowledged")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckMongoatlasAlertAcknowledgementExists(n string, alert *Alert) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No alert ID is set")
+		}
+		return nil
+	}
+}
+
+func TestAccMongoAtlasAlertAcknowledgementAcknowledgedUntil_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "2030-01-01T00:00:00Z",
+			ErrCount: 0,
+		},
+		{
+			Value:    "2030-01-01T02:00:00+02:00",
+			ErrCount: 0,
+		},
+		{
+			Value:    "2030-01-01",
+			ErrCount: 1,
+		},
+		{
+			Value:    "tomorrow",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateRFC3339Time(tc.Value, "mongoatlas_alert_acknowledgement_acknowledgeduntil")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %+v Validation Error, Got %+v Validation Error for %+v VALUE", tc.ErrCount, len(errors), tc.Value)
+		}
+	}
+}
+
+func TestAccMongoAtlasAlertAcknowledgementSuppressEquivalentTime(t *testing.T) {
+	if !suppressEquivalentTime("acknowledgedUntil", "2030-01-01T00:00:00Z", "2030-01-01T02:00:00+02:00", nil) {
+		t.Fatalf("Expected the same instant in another offset to be suppressed")
+	}
+	if suppressEquivalentTime("acknowledgedUntil", "2030-01-01T00:00:00Z", "2030-01-02T00:00:00Z", nil) {
+		t.Fatalf("Expected a different instant not to be suppressed")
+	}
+}
@@ -0,0 +1,479 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"io/ioutil"
+	"log"
+)
+
+type AlertConfiguration struct {
+	Id              string           `json:"id,omitempty"`
+	GroupId         string           `json:"groupId,omitempty"`
+	EventTypeName   string           `json:"eventTypeName,omitempty"`
+	Enabled         *bool            `json:"enabled,omitempty"`
+	Matchers        []Matcher        `json:"matchers"`
+	MetricThreshold *MetricThreshold `json:"metricThreshold,omitempty"`
+	Notifications   []Notification   `json:"notifications,omitempty"`
+}
+
+type Matcher struct {
+	FieldName string `json:"fieldName,omitempty"`
+	Operator  string `json:"operator,omitempty"`
+	Value     string `json:"value,omitempty"`
+}
+
+type MetricThreshold struct {
+	MetricName string  `json:"metricName,omitempty"`
+	Operator   string  `json:"operator,omitempty"`
+	Threshold  float64 `json:"threshold"`
+	Units      string  `json:"units,omitempty"`
+	Mode       string  `json:"mode,omitempty"`
+}
+
+type Notification struct {
+	TypeName      string `json:"typeName,omitempty"`
+	IntervalMin   int    `json:"intervalMin,omitempty"`
+	DelayMin      int    `json:"delayMin"`
+	EmailAddress  string `json:"emailAddress,omitempty"`
+	MobileNumber  string `json:"mobileNumber,omitempty"`
+	ChannelName   string `json:"channelName,omitempty"`
+	ApiToken      string `json:"apiToken,omitempty"`
+	ServiceKey    string `json:"serviceKey,omitempty"`
+	WebhookUrl    string `json:"webhookUrl,omitempty"`
+	WebhookSecret string `json:"webhookSecret,omitempty"`
+	Username      string `json:"username,omitempty"`
+	TeamId        string `json:"teamId,omitempty"`
+	EmailEnabled  *bool  `json:"emailEnabled,omitempty"`
+	SmsEnabled    *bool  `json:"smsEnabled,omitempty"`
+}
+
+func resourceAlertConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlertConfigurationCreate,
+		Update: resourceAlertConfigurationUpdate,
+		Read:   resourceAlertConfigurationRead,
+		Delete: resourceAlertConfigurationDelete,
+		Schema: map[string]*schema.Schema{
+			"groupId": groupIdSchema(),
+			"eventTypeName": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"matchers": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fieldName": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"operator": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateMatcherOperator,
+						},
+						"value": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"metricThreshold": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metricName": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"operator": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateThresholdOperator,
+						},
+						"threshold": &schema.Schema{
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+						"units": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"mode": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "AVERAGE",
+						},
+					},
+				},
+			},
+			"notifications": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				Set:      notificationHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"typeName": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateNotificationTypeName,
+						},
+						"intervalMin": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  60,
+						},
+						"delayMin": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"emailAddress": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"mobileNumber": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"channelName": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"apiToken": &schema.Schema{
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"serviceKey": &schema.Schema{
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"webhookUrl": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"webhookSecret": &schema.Schema{
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"username": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"teamId": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"emailEnabled": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"smsEnabled": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// notificationHash leaves out the secrets, Atlas only returns them redacted so
+// they would otherwise change the hash of every notification on each read.
+func notificationHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	for _, k := range []string{"typeName", "emailAddress", "mobileNumber", "channelName", "webhookUrl", "username", "teamId"} {
+		buf.WriteString(fmt.Sprintf("%s-", m[k].(string)))
+	}
+	buf.WriteString(fmt.Sprintf("%d-%d-", m["intervalMin"].(int), m["delayMin"].(int)))
+	buf.WriteString(fmt.Sprintf("%t-%t-", m["emailEnabled"].(bool), m["smsEnabled"].(bool)))
+	return hashcode.String(buf.String())
+}
+
+func newAlertConfiguration(d *schema.ResourceData) *AlertConfiguration {
+	enabled := new(bool)
+	*enabled = d.Get("enabled").(bool)
+
+	alertconfiguration := &AlertConfiguration{
+		EventTypeName: d.Get("eventTypeName").(string),
+		Enabled:       enabled,
+		Matchers:      []Matcher{},
+	}
+
+	for _, matcherInterface := range d.Get("matchers").(*schema.Set).List() {
+		matcherMap := matcherInterface.(map[string]interface{})
+		alertconfiguration.Matchers = append(alertconfiguration.Matchers, Matcher{
+			FieldName: matcherMap["fieldName"].(string),
+			Operator:  matcherMap["operator"].(string),
+			Value:     matcherMap["value"].(string),
+		})
+	}
+
+	if thresholds := d.Get("metricThreshold").([]interface{}); len(thresholds) > 0 {
+		thresholdMap := thresholds[0].(map[string]interface{})
+		alertconfiguration.MetricThreshold = &MetricThreshold{
+			MetricName: thresholdMap["metricName"].(string),
+			Operator:   thresholdMap["operator"].(string),
+			Threshold:  thresholdMap["threshold"].(float64),
+			Units:      thresholdMap["units"].(string),
+			Mode:       thresholdMap["mode"].(string),
+		}
+	}
+
+	for _, notificationInterface := range d.Get("notifications").(*schema.Set).List() {
+		notificationMap := notificationInterface.(map[string]interface{})
+		notification := Notification{
+			TypeName:      notificationMap["typeName"].(string),
+			IntervalMin:   notificationMap["intervalMin"].(int),
+			DelayMin:      notificationMap["delayMin"].(int),
+			EmailAddress:  notificationMap["emailAddress"].(string),
+			MobileNumber:  notificationMap["mobileNumber"].(string),
+			ChannelName:   notificationMap["channelName"].(string),
+			ApiToken:      notificationMap["apiToken"].(string),
+			ServiceKey:    notificationMap["serviceKey"].(string),
+			WebhookUrl:    notificationMap["webhookUrl"].(string),
+			WebhookSecret: notificationMap["webhookSecret"].(string),
+			Username:      notificationMap["username"].(string),
+			TeamId:        notificationMap["teamId"].(string),
+		}
+
+		// emailEnabled and smsEnabled only apply to group, user and team notifications
+		if stringInSlice(notification.TypeName, []string{"GROUP", "USER", "TEAM"}) {
+			emailEnabled := new(bool)
+			*emailEnabled = notificationMap["emailEnabled"].(bool)
+			notification.EmailEnabled = emailEnabled
+
+			smsEnabled := new(bool)
+			*smsEnabled = notificationMap["smsEnabled"].(bool)
+			notification.SmsEnabled = smsEnabled
+		}
+
+		alertconfiguration.Notifications = append(alertconfiguration.Notifications, notification)
+	}
+
+	return alertconfiguration
+}
+
+func resourceAlertConfigurationCreate(d *schema.ResourceData, m interface{}) error {
+	// Instantiate the MongoatlasClient. Source in client.go, it handles all the HTTP REST API communication
+	client := m.(*MongoatlasClient)
+
+	alertconfiguration := newAlertConfiguration(d)
+
+	var jsonbuffer []byte
+
+	jsonpayload := bytes.NewBuffer(jsonbuffer)
+	enc := json.NewEncoder(jsonpayload)
+	enc.Encode(alertconfiguration)
+
+	// the payload carries notification secrets, so it is not logged
+	log.Printf("Creating alert configuration for event %s \n", alertconfiguration.EventTypeName)
+
+	// communication with API commence here
+	alertconfiguration_req, err := client.Post(fmt.Sprintf("groups/%s/alertConfigs",
+		d.Get("groupId").(string),
+	), jsonpayload)
+	if err != nil {
+		return err
+	}
+
+	if alertconfiguration_req.StatusCode != 201 {
+		body, err := ioutil.ReadAll(alertconfiguration_req.Body)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("Failed to create alert configuration. Got the following response body %s", string(body))
+	}
+
+	var created AlertConfiguration
+
+	decoder := json.NewDecoder(alertconfiguration_req.Body)
+	err = decoder.Decode(&created)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(created.Id)
+
+	return resourceAlertConfigurationRead(d, m)
+}
+
+func resourceAlertConfigurationRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	alertconfiguration_req, err := client.Get(fmt.Sprintf("groups/%s/alertConfigs/%s",
+		d.Get("groupId").(string),
+		d.Id(),
+	))
+	if err != nil {
+		return err
+	}
+
+	if alertconfiguration_req.StatusCode == 404 {
+		log.Printf("[DEBUG] alert configuration %s no longer exist, so we'll drop it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if alertconfiguration_req.StatusCode != 200 {
+		body, err := ioutil.ReadAll(alertconfiguration_req.Body)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("Failed to read alert configuration. Got the following response body %s", string(body))
+	}
+
+	var alertconfiguration AlertConfiguration
+
+	decoder := json.NewDecoder(alertconfiguration_req.Body)
+	err = decoder.Decode(&alertconfiguration)
+	if err != nil {
+		return err
+	}
+
+	d.Set("eventTypeName", alertconfiguration.EventTypeName)
+	if alertconfiguration.Enabled != nil {
+		d.Set("enabled", *alertconfiguration.Enabled)
+	}
+
+	var matchers []map[string]interface{}
+	for _, t := range alertconfiguration.Matchers {
+		matchers = append(matchers, map[string]interface{}{
+			"fieldName": t.FieldName,
+			"operator":  t.Operator,
+			"value":     t.Value,
+		})
+	}
+	if err := d.Set("matchers", matchers); err != nil {
+		return err
+	}
+
+	var thresholds []map[string]interface{}
+	if t := alertconfiguration.MetricThreshold; t != nil {
+		thresholds = append(thresholds, map[string]interface{}{
+			"metricName": t.MetricName,
+			"operator":   t.Operator,
+			"threshold":  t.Threshold,
+			"units":      t.Units,
+			"mode":       t.Mode,
+		})
+	}
+	if err := d.Set("metricThreshold", thresholds); err != nil {
+		return err
+	}
+
+	if err := d.Set("notifications", flattenNotifications(d, alertconfiguration.Notifications)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// flattenNotifications converts the notifications returned by Atlas, keeping
+// the secrets already in the state since Atlas only returns them redacted.
+func flattenNotifications(d *schema.ResourceData, notifications []Notification) []interface{} {
+	secrets := map[int]map[string]interface{}{}
+	if current, ok := d.Get("notifications").(*schema.Set); ok {
+		for _, v := range current.List() {
+			secrets[notificationHash(v)] = v.(map[string]interface{})
+		}
+	}
+
+	var s []interface{}
+	for _, t := range notifications {
+		mapping := map[string]interface{}{
+			"typeName":      t.TypeName,
+			"intervalMin":   t.IntervalMin,
+			"delayMin":      t.DelayMin,
+			"emailAddress":  t.EmailAddress,
+			"mobileNumber":  t.MobileNumber,
+			"channelName":   t.ChannelName,
+			"apiToken":      t.ApiToken,
+			"serviceKey":    t.ServiceKey,
+			"webhookUrl":    t.WebhookUrl,
+			"webhookSecret": t.WebhookSecret,
+			"username":      t.Username,
+			"teamId":        t.TeamId,
+			"emailEnabled":  t.EmailEnabled != nil && *t.EmailEnabled,
+			"smsEnabled":    t.SmsEnabled != nil && *t.SmsEnabled,
+		}
+
+		if known, ok := secrets[notificationHash(mapping)]; ok {
+			mapping["apiToken"] = known["apiToken"]
+			mapping["serviceKey"] = known["serviceKey"]
+			mapping["webhookSecret"] = known["webhookSecret"]
+		}
+
+		s = append(s, mapping)
+	}
+
+	return s
+}
+
+func resourceAlertConfigurationUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	// alertConfigs only supports a full replacement of the document
+	alertconfiguration := newAlertConfiguration(d)
+
+	var jsonbuffer []byte
+
+	jsonpayload := bytes.NewBuffer(jsonbuffer)
+	enc := json.NewEncoder(jsonpayload)
+	enc.Encode(alertconfiguration)
+
+	log.Printf("Updating alert configuration %s \n", d.Id())
+
+	alertconfiguration_req, err := client.Put(fmt.Sprintf("groups/%s/alertConfigs/%s",
+		d.Get("groupId").(string),
+		d.Id(),
+	), jsonpayload)
+	if err != nil {
+		return err
+	}
+
+	if alertconfiguration_req.StatusCode != 200 {
+		body, err := ioutil.ReadAll(alertconfiguration_req.Body)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("Failed to update alert configuration: %s", string(body))
+	}
+
+	return resourceAlertConfigurationRead(d, m)
+}
+
+func resourceAlertConfigurationDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	delete_response, err := client.Delete(fmt.Sprintf("groups/%s/alertConfigs/%s",
+		d.Get("groupId").(string),
+		d.Id(),
+	))
+	if err != nil {
+		return err
+	}
+
+	if delete_response.StatusCode != 200 && delete_response.StatusCode != 204 && delete_response.StatusCode != 404 {
+		return fmt.Errorf("Failed to delete the alert configuration. Got status code %d", delete_response.StatusCode)
+	}
+	return nil
+}
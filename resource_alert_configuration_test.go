@@ -0,0 +1,238 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccMongoatlasAlertConfiguration_basic(t *testing.T) {
+	var alertconfiguration AlertConfiguration
+
+	testGroupId := os.Getenv("MONGOATLAS_GROUPID")
+
+	testAccMongoatlasAlertConfigurationConfig := fmt.Sprintf(
+		`resource "mongoatlas_alert_configuration" "acceptancetest_alertconfiguration" {
+			groupId = "%s"
+			eventTypeName = "OUTSIDE_METRIC_THRESHOLD"
+			enabled = true
+			matchers = [
+				{
+					fieldName = "HOSTNAME_AND_PORT"
+					operator = "STARTS_WITH"
+					value = "terratest"
+				}
+			]
+			metricThreshold {
+				metricName = "ASSERT_REGULAR"
+				operator = "GREATER_THAN"
+				threshold = 99.0
+				units = "RAW"
+			}
+			notifications = [
+				{
+					typeName = "GROUP"
+					intervalMin = 5
+					emailEnabled = true
+				},
+				{
+					typeName = "EMAIL"
+					emailAddress = "terratest@example.com"
+				}
+			]
+		}
+	`, testGroupId)
+
+	testAccMongoatlasAlertConfigurationConfig_update := fmt.Sprintf(
+		`resource "mongoatlas_alert_configuration" "acceptancetest_alertconfiguration" {
+			groupId = "%s"
+			eventTypeName = "OUTSIDE_METRIC_THRESHOLD"
+			enabled = false
+			metricThreshold {
+				metricName = "ASSERT_REGULAR"
+				operator = "GREATER_THAN"
+				threshold = 50.0
+				units = "RAW"
+			}
+			notifications = [
+				{
+					typeName = "EMAIL"
+					emailAddress = "terratest@example.com"
+				},
+				{
+					typeName = "GROUP"
+					intervalMin = 5
+					emailEnabled = true
+				}
+			]
+		}
+	`, testGroupId)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoatlasAlertConfigurationDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccMongoatlasAlertConfigurationConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoatlasAlertConfigurationExists("mongoatlas_alert_configuration.acceptancetest_alertconfiguration", &alertconfiguration),
+					resource.TestCheckResourceAttr(
+						"mongoatlas_alert_configuration.acceptancetest_alertconfiguration", "notifications.#", "2"),
+				),
+			},
+
+			resource.TestStep{
+				Config: testAccMongoatlasAlertConfigurationConfig_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoatlasAlertConfigurationExists("mongoatlas_alert_configuration.acceptancetest_alertconfiguration", &alertconfiguration),
+					resource.TestCheckResourceAttr(
+						"mongoatlas_alert_configuration.acceptancetest_alertconfiguration", "enabled", "false"),
+					resource.TestCheckResourceAttr(
+						"mongoatlas_alert_configuration.acceptancetest_alertconfiguration", "matchers.#", "0"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccCheckMongoatlasAlertConfigurationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*MongoatlasClient)
+	rs, ok := s.RootModule().Resources["mongoatlas_alert_configuration.acceptancetest_alertconfiguration"]
+	if !ok {
+		return fmt.Errorf("Not found %s", "mongoatlas_alert_configuration.acceptancetest_alertconfiguration")
+	}
+
+	response, err := client.Get(fmt.Sprintf("groups/%s/alertConfigs/%s", rs.Primary.Attributes["groupId"], rs.Primary.ID))
+
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode != 404 {
+		return fmt.Errorf("AlertConfiguration still exists")
+	}
+
+	return nil
+}
+
+func testAccCheckMongoatlasAlertConfigurationExists(n string, alertconfiguration *AlertConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No alert configuration ID is set")
+		}
+		return nil
+	}
+}
+
+func TestAccMongoAtlasAlertConfigurationNotificationHash(t *testing.T) {
+	notification := map[string]interface{}{
+		"typeName":      "SLACK",
+		"intervalMin":   60,
+		"delayMin":      0,
+		"emailAddress":  "",
+		"mobileNumber":  "",
+		"channelName":   "#alerts",
+		"apiToken":      "xoxb-secret",
+		"serviceKey":    "",
+		"webhookUrl":    "",
+		"webhookSecret": "",
+		"username":      "",
+		"teamId":        "",
+		"emailEnabled":  false,
+		"smsEnabled":    false,
+	}
+
+	redacted := map[string]interface{}{}
+	for k, v := range notification {
+		redacted[k] = v
+	}
+	redacted["apiToken"] = "****************cret"
+
+	if notificationHash(notification) != notificationHash(redacted) {
+		t.Fatalf("Expected redacted secrets not to change the notification hash")
+	}
+
+	redacted["channelName"] = "#other"
+	if notificationHash(notification) == notificationHash(redacted) {
+		t.Fatalf("Expected a different channel to change the notification hash")
+	}
+
+	set := schema.NewSet(notificationHash, []interface{}{notification, redacted})
+	if set.Len() != 2 {
+		t.Fatalf("Expected 2 notifications, got %d", set.Len())
+	}
+}
+
+func TestAccMongoAtlasAlertConfigurationNotificationTypeName_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "EMAIL",
+			ErrCount: 0,
+		},
+		{
+			Value:    "PAGER_DUTY",
+			ErrCount: 0,
+		},
+		{
+			Value:    "WEBHOOK",
+			ErrCount: 0,
+		},
+		{
+			Value:    "slack",
+			ErrCount: 1,
+		},
+		{
+			Value:    "CARRIER_PIGEON",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateNotificationTypeName(tc.Value, "mongoatlas_alert_configuration_typename")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %+v Validation Error, Got %+v Validation Error for %+v VALUE", tc.ErrCount, len(errors), tc.Value)
+		}
+	}
+}
+
+func TestAccMongoAtlasAlertConfigurationThresholdOperator_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "GREATER_THAN",
+			ErrCount: 0,
+		},
+		{
+			Value:    "LESS_THAN",
+			ErrCount: 0,
+		},
+		{
+			Value:    "EQUALS",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateThresholdOperator(tc.Value, "mongoatlas_alert_configuration_operator")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %+v Validation Error, Got %+v Validation Error for %+v VALUE", tc.ErrCount, len(errors), tc.Value)
+		}
+	}
+}
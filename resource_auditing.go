@@ -0,0 +1,177 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"io/ioutil"
+	"log"
+	"reflect"
+)
+
+type Auditing struct {
+	Enabled                   *bool  `json:"enabled,omitempty"`
+	AuditFilter               string `json:"auditFilter,omitempty"`
+	AuditAuthorizationSuccess *bool  `json:"auditAuthorizationSuccess,omitempty"`
+	ConfigurationType         string `json:"configurationType,omitempty"`
+}
+
+func resourceAuditing() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAuditingCreate,
+		Update: resourceAuditingUpdate,
+		Read:   resourceAuditingRead,
+		Delete: resourceAuditingDelete,
+		Schema: map[string]*schema.Schema{
+			"groupId": groupIdSchema(),
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"auditFilter": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateJsonString,
+				DiffSuppressFunc: suppressEquivalentJson,
+			},
+			"auditAuthorizationSuccess": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"configurationType": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// suppressEquivalentJson compares two JSON documents by value, Atlas does not
+// preserve the formatting nor the key order of the auditFilter it stores. An
+// empty value stands for the empty filter Atlas reports once it is removed.
+func suppressEquivalentJson(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" {
+		old = "{}"
+	}
+	if new == "" {
+		new = "{}"
+	}
+
+	var oldJson, newJson interface{}
+	if err := json.Unmarshal([]byte(old), &oldJson); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &newJson); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(oldJson, newJson)
+}
+
+func resourceAuditingCreate(d *schema.ResourceData, m interface{}) error {
+	d.SetId(d.Get("groupId").(string))
+
+	return resourceAuditingUpdate(d, m)
+}
+
+func resourceAuditingRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	auditing_req, err := client.Get(fmt.Sprintf("groups/%s/auditLog",
+		d.Get("groupId").(string),
+	))
+	if err != nil {
+		return err
+	}
+
+	if auditing_req.StatusCode != 200 {
+		body, err := ioutil.ReadAll(auditing_req.Body)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("Failed to read auditing configuration. Got the following response body %s", string(body))
+	}
+
+	var auditing Auditing
+
+	decoder := json.NewDecoder(auditing_req.Body)
+	err = decoder.Decode(&auditing)
+	if err != nil {
+		return err
+	}
+	log.Printf("Received %+v \n", auditing)
+
+	if auditing.Enabled != nil {
+		d.Set("enabled", *auditing.Enabled)
+	}
+	if auditing.AuditAuthorizationSuccess != nil {
+		d.Set("auditAuthorizationSuccess", *auditing.AuditAuthorizationSuccess)
+	}
+	d.Set("auditFilter", auditing.AuditFilter)
+	d.Set("configurationType", auditing.ConfigurationType)
+
+	return nil
+}
+
+func patchAuditing(d *schema.ResourceData, m interface{}, auditing *Auditing) error {
+	client := m.(*MongoatlasClient)
+
+	var jsonbuffer []byte
+
+	jsonpayload := bytes.NewBuffer(jsonbuffer)
+	enc := json.NewEncoder(jsonpayload)
+	enc.Encode(auditing)
+
+	log.Printf("Sending %s \n", jsonpayload)
+
+	auditing_req, err := client.Patch(fmt.Sprintf("groups/%s/auditLog",
+		d.Get("groupId").(string),
+	), jsonpayload)
+	if err != nil {
+		return err
+	}
+
+	if auditing_req.StatusCode != 200 {
+		body, err := ioutil.ReadAll(auditing_req.Body)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("Failed to patch auditing configuration: %s", string(body))
+	}
+
+	return nil
+}
+
+func resourceAuditingUpdate(d *schema.ResourceData, m interface{}) error {
+	enabled := new(bool)
+	*enabled = d.Get("enabled").(bool)
+
+	auditAuthorizationSuccess := new(bool)
+	*auditAuthorizationSuccess = d.Get("auditAuthorizationSuccess").(bool)
+
+	// the filter is always sent, so removing it from the configuration
+	// resets it to the empty filter
+	auditing := &Auditing{
+		Enabled:                   enabled,
+		AuditFilter:               "{}",
+		AuditAuthorizationSuccess: auditAuthorizationSuccess,
+	}
+
+	if attr, ok := d.GetOk("auditFilter"); ok {
+		auditing.AuditFilter = attr.(string)
+	}
+
+	if err := patchAuditing(d, m, auditing); err != nil {
+		return err
+	}
+
+	return resourceAuditingRead(d, m)
+}
+
+func resourceAuditingDelete(d *schema.ResourceData, m interface{}) error {
+	// the audit log configuration cannot be removed from a project, only disabled
+	enabled := new(bool)
+	*enabled = false
+
+	return patchAuditing(d, m, &Auditing{Enabled: enabled})
+}
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccMongoatlasAuditing_basic(t *testing.T) {
+	var auditing Auditing
+
+	testGroupId := os.Getenv("MONGOATLAS_GROUPID")
+
+	testAccMongoatlasAuditingConfig := fmt.Sprintf(
+		`resource "mongoatlas_auditing" "acceptancetest_auditing" {
+			groupId = "%s"
+			enabled = true
+			auditAuthorizationSuccess = false
+			auditFilter = <<EOF
+{
+  "atype": "authenticate",
+  "param": { "user": "auditReadOnly", "db": "admin", "mechanism": "SCRAM-SHA-1" }
+}
+EOF
+		}
+	`, testGroupId)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoatlasAuditingDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccMongoatlasAuditingConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoatlasAuditingExists("mongoatlas_auditing.acceptancetest_auditing", &auditing),
+					resource.TestCheckResourceAttr(
+						"mongoatlas_auditing.acceptancetest_auditing", "enabled", "true"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccCheckMongoatlasAuditingDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*MongoatlasClient)
+	rs, ok := s.RootModule().Resources["mongoatlas_auditing.acceptancetest_auditing"]
+	if !ok {
+		return fmt.Errorf("Not found %s", "mongoatlas_auditing.acceptancetest_auditing")
+	}
+
+	response, err := client.Get(fmt.Sprintf("groups/%s/auditLog", rs.Primary.Attributes["groupId"]))
+
+	if err != nil {
+		return err
+	}
+
+	var auditing Auditing
+
+	decoder := json.NewDecoder(response.Body)
+	err = decoder.Decode(&auditing)
+	if err != nil {
+		return err
+	}
+
+	if auditing.Enabled != nil && *auditing.Enabled {
+		return fmt.Errorf("Auditing is still enabled")
+	}
+
+	return nil
+}
+
+func testAccCheckMongoatlasAuditingExists(n string, auditing *Auditing) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No auditing ID is set")
+		}
+		return nil
+	}
+}
+
+func TestAccMongoAtlasAuditingAuditFilter_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    `{"atype": "authenticate"}`,
+			ErrCount: 0,
+		},
+		{
+			Value:    `{}`,
+			ErrCount: 0,
+		},
+		{
+			Value:    `{"atype": }`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `["authenticate"]`,
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateJsonString(tc.Value, "mongoatlas_auditing_auditfilter")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %+v Validation Error, Got %+v Validation Error for %+v VALUE", tc.ErrCount, len(errors), tc.Value)
+		}
+	}
+}
+
+func TestAccMongoAtlasAuditingSuppressEquivalentJson(t *testing.T) {
+	cases := []struct {
+		Old      string
+		New      string
+		Suppress bool
+	}{
+		{
+			Old:      `{"atype":"authenticate","param":{"db":"admin"}}`,
+			New:      "{\n  \"param\": { \"db\": \"admin\" },\n  \"atype\": \"authenticate\"\n}\n",
+			Suppress: true,
+		},
+		{
+			Old:      `{"atype":"authenticate"}`,
+			New:      `{"atype":"authCheck"}`,
+			Suppress: false,
+		},
+		{
+			Old:      `{"atype":"authenticate"}`,
+			New:      `not json`,
+			Suppress: false,
+		},
+		{
+			Old:      `{}`,
+			New:      "",
+			Suppress: true,
+		},
+		{
+			Old:      `{"atype":"authenticate"}`,
+			New:      "",
+			Suppress: false,
+		},
+	}
+
+	for _, tc := range cases {
+		if suppressEquivalentJson("auditFilter", tc.Old, tc.New, nil) != tc.Suppress {
+			t.Fatalf("Expected suppress to be %t for %s and %s", tc.Suppress, tc.Old, tc.New)
+		}
+	}
+}
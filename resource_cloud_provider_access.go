@@ -0,0 +1,298 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"io/ioutil"
+	"log"
+	"time"
+)
+
+type CloudProviderAccessRole struct {
+	RoleId                     string `json:"roleId,omitempty"`
+	ProviderName               string `json:"providerName,omitempty"`
+	AtlasAWSAccountArn         string `json:"atlasAWSAccountArn,omitempty"`
+	AtlasAssumedRoleExternalId string `json:"atlasAssumedRoleExternalId,omitempty"`
+	IamAssumedRoleArn          string `json:"iamAssumedRoleArn,omitempty"`
+	CreatedDate                string `json:"createdDate,omitempty"`
+	AuthorizedDate             string `json:"authorizedDate,omitempty"`
+}
+
+type CloudProviderAccess struct {
+	AwsIamRoles []CloudProviderAccessRole `json:"awsIamRoles,omitempty"`
+}
+
+// mongoatlas_cloud_provider_access creates the Atlas side of the role, the
+// returned ARN and external ID feed an aws_iam_role trust policy which is then
+// handed back to Atlas through mongoatlas_cloud_provider_access_authorization.
+func resourceCloudProviderAccess() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudProviderAccessCreate,
+		Read:   resourceCloudProviderAccessRead,
+		Delete: resourceCloudProviderAccessDelete,
+		Schema: map[string]*schema.Schema{
+			"groupId": groupIdSchema(),
+			"providerName": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "AWS",
+				ValidateFunc: validateProviderName,
+			},
+			"roleId": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"atlasAWSAccountArn": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"atlasAssumedRoleExternalId": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"createdDate": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCloudProviderAccessAuthorization() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudProviderAccessAuthorizationCreate,
+		Update: resourceCloudProviderAccessAuthorizationUpdate,
+		Read:   resourceCloudProviderAccessAuthorizationRead,
+		Delete: resourceCloudProviderAccessAuthorizationDelete,
+		Schema: map[string]*schema.Schema{
+			"groupId": groupIdSchema(),
+			"roleId": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"iamAssumedRoleArn": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"authorizedDate": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// getCloudProviderAccessRole looks a role up in the project, Atlas only lists
+// them all. A nil role means it does not exist anymore.
+func getCloudProviderAccessRole(client *MongoatlasClient, groupId string, roleId string) (*CloudProviderAccessRole, error) {
+	cloudprovideraccess_req, err := client.Get(fmt.Sprintf("groups/%s/cloudProviderAccess", groupId))
+	if err != nil {
+		return nil, err
+	}
+
+	if cloudprovideraccess_req.StatusCode != 200 {
+		body, err := ioutil.ReadAll(cloudprovideraccess_req.Body)
+		if err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("Failed to read cloud provider access. Got the following response body %s", string(body))
+	}
+
+	var cloudprovideraccess CloudProviderAccess
+
+	decoder := json.NewDecoder(cloudprovideraccess_req.Body)
+	err = decoder.Decode(&cloudprovideraccess)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, role := range cloudprovideraccess.AwsIamRoles {
+		if role.RoleId == roleId {
+			return &role, nil
+		}
+	}
+	return nil, nil
+}
+
+func resourceCloudProviderAccessCreate(d *schema.ResourceData, m interface{}) error {
+	// Instantiate the MongoatlasClient. Source in client.go, it handles all the HTTP REST API communication
+	client := m.(*MongoatlasClient)
+
+	role := &CloudProviderAccessRole{
+		ProviderName: d.Get("providerName").(string),
+	}
+
+	var jsonbuffer []byte
+
+	jsonpayload := bytes.NewBuffer(jsonbuffer)
+	enc := json.NewEncoder(jsonpayload)
+	enc.Encode(role)
+
+	log.Printf("Sending %s \n", jsonpayload)
+
+	// communication with API commence here
+	role_req, err := client.Post(fmt.Sprintf("groups/%s/cloudProviderAccess",
+		d.Get("groupId").(string),
+	), jsonpayload)
+	if err != nil {
+		return err
+	}
+
+	if role_req.StatusCode != 200 && role_req.StatusCode != 201 {
+		body, err := ioutil.ReadAll(role_req.Body)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("Failed to create cloud provider access role. Got the following response body %s", string(body))
+	}
+
+	decoder := json.NewDecoder(role_req.Body)
+	err = decoder.Decode(&role)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(role.RoleId)
+
+	return resourceCloudProviderAccessRead(d, m)
+}
+
+func resourceCloudProviderAccessRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	role, err := getCloudProviderAccessRole(client, d.Get("groupId").(string), d.Id())
+	if err != nil {
+		return err
+	}
+
+	if role == nil {
+		log.Printf("[DEBUG] cloud provider access role %s no longer exist, so we'll drop it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("providerName", role.ProviderName)
+	d.Set("roleId", role.RoleId)
+	d.Set("atlasAWSAccountArn", role.AtlasAWSAccountArn)
+	d.Set("atlasAssumedRoleExternalId", role.AtlasAssumedRoleExternalId)
+	d.Set("createdDate", role.CreatedDate)
+
+	return nil
+}
+
+func resourceCloudProviderAccessDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	delete_response, err := client.Delete(fmt.Sprintf("groups/%s/cloudProviderAccess/%s/%s",
+		d.Get("groupId").(string),
+		d.Get("providerName").(string),
+		d.Id(),
+	))
+	if err != nil {
+		return err
+	}
+
+	// Atlas refuses to delete a role still used by encryption at rest or a data lake
+	if delete_response.StatusCode != 200 && delete_response.StatusCode != 204 && delete_response.StatusCode != 404 {
+		body, err := ioutil.ReadAll(delete_response.Body)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("Failed to delete the cloud provider access role. Got the following response body %s", string(body))
+	}
+	return nil
+}
+
+func authorizeCloudProviderAccessRole(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	role := &CloudProviderAccessRole{
+		ProviderName:      "AWS",
+		IamAssumedRoleArn: d.Get("iamAssumedRoleArn").(string),
+	}
+
+	// a freshly created IAM role takes a while to become assumable, until then
+	// Atlas answers with a 400
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		var jsonbuffer []byte
+
+		jsonpayload := bytes.NewBuffer(jsonbuffer)
+		enc := json.NewEncoder(jsonpayload)
+		enc.Encode(role)
+
+		log.Printf("Sending %s \n", jsonpayload)
+
+		role_req, err := client.Patch(fmt.Sprintf("groups/%s/cloudProviderAccess/%s",
+			d.Get("groupId").(string),
+			d.Get("roleId").(string),
+		), jsonpayload)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if role_req.StatusCode == 200 {
+			return nil
+		}
+
+		body, err := ioutil.ReadAll(role_req.Body)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		err = fmt.Errorf("Failed to authorize cloud provider access role. Got the following response body %s", string(body))
+		if role_req.StatusCode == 400 {
+			return resource.RetryableError(err)
+		}
+		return resource.NonRetryableError(err)
+	})
+}
+
+func resourceCloudProviderAccessAuthorizationCreate(d *schema.ResourceData, m interface{}) error {
+	if err := authorizeCloudProviderAccessRole(d, m); err != nil {
+		return err
+	}
+
+	d.SetId(d.Get("roleId").(string))
+
+	return resourceCloudProviderAccessAuthorizationRead(d, m)
+}
+
+func resourceCloudProviderAccessAuthorizationRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	role, err := getCloudProviderAccessRole(client, d.Get("groupId").(string), d.Get("roleId").(string))
+	if err != nil {
+		return err
+	}
+
+	if role == nil || role.IamAssumedRoleArn == "" {
+		log.Printf("[DEBUG] cloud provider access role %s is no longer authorized, so we'll drop it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("iamAssumedRoleArn", role.IamAssumedRoleArn)
+	d.Set("authorizedDate", role.AuthorizedDate)
+
+	return nil
+}
+
+func resourceCloudProviderAccessAuthorizationUpdate(d *schema.ResourceData, m interface{}) error {
+	if err := authorizeCloudProviderAccessRole(d, m); err != nil {
+		return err
+	}
+
+	return resourceCloudProviderAccessAuthorizationRead(d, m)
+}
+
+func resourceCloudProviderAccessAuthorizationDelete(d *schema.ResourceData, m interface{}) error {
+	// Atlas cannot revoke an authorization without deleting the role itself,
+	// which belongs to mongoatlas_cloud_provider_access
+	log.Printf("[DEBUG] cloud provider access role %s stays authorized until the role is deleted", d.Id())
+	return nil
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccMongoatlasCloudProviderAccess_basic(t *testing.T) {
+	var role CloudProviderAccessRole
+
+	testGroupId := os.Getenv("MONGOATLAS_GROUPID")
+
+	testAccMongoatlasCloudProviderAccessConfig := fmt.Sprintf(
+		`resource "mongoatlas_cloud_provider_access" "acceptancetest_role" {
+			groupId = "%s"
+			providerName = "AWS"
+		}
+	`, testGroupId)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoatlasCloudProviderAccessDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccMongoatlasCloudProviderAccessConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoatlasCloudProviderAccessExists("mongoatlas_cloud_provider_access.acceptancetest_role", &role),
+					resource.TestCheckResourceAttrSet(
+						"mongoatlas_cloud_provider_access.acceptancetest_role", "atlasAWSAccountArn"),
+					resource.TestCheckResourceAttrSet(
+						"mongoatlas_cloud_provider_access.acceptancetest_role", "atlasAssumedRoleExternalId"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccCheckMongoatlasCloudProviderAccessDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*MongoatlasClient)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mongoatlas_cloud_provider_access" {
+			continue
+		}
+
+		role, err := getCloudProviderAccessRole(client, rs.Primary.Attributes["groupId"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if role != nil {
+			return fmt.Errorf("Cloud provider access role %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckMongoatlasCloudProviderAccessExists(n string, role *CloudProviderAccessRole) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No cloud provider access role ID is set")
+		}
+
+		client := testAccProvider.Meta().(*MongoatlasClient)
+		found, err := getCloudProviderAccessRole(client, rs.Primary.Attributes["groupId"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if found == nil {
+			return fmt.Errorf("Cloud provider access role %s not found", rs.Primary.ID)
+		}
+
+		*role = *found
+		return nil
+	}
+}
@@ -11,17 +11,28 @@ import (
 )
 
 type Cluster struct {
-	Name              string            `json:"name,omitempty"`
-	BackupEnabled     *bool             `json:"backupEnabled,omitempty"`
-	MongoDBMajorVersion string 			`json:"mongoDBMajorVersion,omitempty"`
-	MongoDBVersion    string            `json:"mongoDBVersion,omitempty"`
-	MongoURI          string            `json:"mongoURI,omitempty"`
-	MongoURIUpdated   string            `json:"mongoURIUpdated,omitempty"`
-	NumShards         int               `json:"numShards,omitempty"`
-	ReplicationFactor int               `json:"replicationFactor,omitempty"`
-	ProviderSettings  *ProviderSettings `json:"providerSettings,omitempty"`
-	DiskSizeGB        float64           `json:"diskSizeGB,omitempty"`
-	StateName         string            `json:"stateName,omitempty"`
+	Name                     string             `json:"name,omitempty"`
+	BackupEnabled            *bool              `json:"backupEnabled,omitempty"`
+	MongoDBMajorVersion      string             `json:"mongoDBMajorVersion,omitempty"`
+	MongoDBVersion           string             `json:"mongoDBVersion,omitempty"`
+	MongoURI                 string             `json:"mongoURI,omitempty"`
+	MongoURIUpdated          string             `json:"mongoURIUpdated,omitempty"`
+	MongoURIWithOptions      string             `json:"mongoURIWithOptions,omitempty"`
+	SrvAddress               string             `json:"srvAddress,omitempty"`
+	ConnectionStrings        *ConnectionStrings `json:"connectionStrings,omitempty"`
+	NumShards                int                `json:"numShards,omitempty"`
+	ReplicationFactor        int                `json:"replicationFactor,omitempty"`
+	ProviderSettings         *ProviderSettings  `json:"providerSettings,omitempty"`
+	DiskSizeGB               float64            `json:"diskSizeGB,omitempty"`
+	StateName                string             `json:"stateName,omitempty"`
+	EncryptionAtRestProvider string             `json:"encryptionAtRestProvider,omitempty"`
+}
+
+type ConnectionStrings struct {
+	Standard    string `json:"standard,omitempty"`
+	StandardSrv string `json:"standardSrv,omitempty"`
+	Private     string `json:"private,omitempty"`
+	PrivateSrv  string `json:"privateSrv,omitempty"`
 }
 
 type ProviderSettings struct {
@@ -43,10 +54,7 @@ func resourceCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
-			"groupId": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-			},
+			"groupId": groupIdSchema(),
 			"backupEnabled": &schema.Schema{
 				Type:     schema.TypeBool,
 				Required: true,
@@ -65,10 +73,38 @@ func resourceCluster() *schema.Resource {
 				Type: schema.TypeString,
 				Computed: true,
 			},
+			"mongoURI": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"mongoURIUpdated": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"mongoURIWithOptions": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"srvAddress": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connectionStringStandard": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connectionStringStandardSrv": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connectionStringPrivate": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connectionStringPrivateSrv": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"numShards": &schema.Schema{
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -110,6 +146,12 @@ func resourceCluster() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"encryptionAtRestProvider": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateEncryptionAtRestProvider,
+				Optional:     true,
+				Computed:     true,
+			},
 		},
 	}
 }
@@ -156,6 +198,10 @@ func newCluster(d *schema.ResourceData) (*Cluster, error) {
 		cluster.DiskSizeGB = attr.(float64)
 	}
 
+	if attr, ok := d.GetOk("encryptionAtRestProvider"); ok {
+		cluster.EncryptionAtRestProvider = attr.(string)
+	}
+
 	return cluster, nil
 }
 
@@ -169,6 +215,10 @@ func resourceClusterCreate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if err := checkEncryptionAtRestProvider(client, d.Get("groupId").(string), cluster.EncryptionAtRestProvider); err != nil {
+		return err
+	}
+
 	var jsonbuffer []byte
 
 	jsonpayload := bytes.NewBuffer(jsonbuffer)
@@ -211,7 +261,11 @@ func resourceClusterCreate(d *schema.ResourceData, m interface{}) error {
 	d.Set("diskSizeGB", cluster.DiskSizeGB)
 	d.Set("mongoDBMajorVersion", cluster.MongoDBMajorVersion)
 	d.Set("mongoDBVersion", cluster.MongoDBVersion)
+	d.Set("mongoURI", cluster.MongoURI)
 	d.Set("mongoURIUpdated", cluster.MongoURIUpdated)
+	d.Set("mongoURIWithOptions", cluster.MongoURIWithOptions)
+	d.Set("srvAddress", cluster.SrvAddress)
+	setClusterConnectionStrings(d, cluster.ConnectionStrings)
 	d.Set("numShards", cluster.NumShards)
 	d.Set("providerName", cluster.ProviderSettings.ProviderName)
 	d.Set("diskIOPS", cluster.ProviderSettings.DiskIOPS)
@@ -220,6 +274,7 @@ func resourceClusterCreate(d *schema.ResourceData, m interface{}) error {
 	d.Set("regionName", cluster.ProviderSettings.RegionName)
 	d.Set("replicationFactor", cluster.ReplicationFactor)
 	d.Set("stateName", cluster.StateName)
+	d.Set("encryptionAtRestProvider", cluster.EncryptionAtRestProvider)
 
 	return resourceClusterRead(d, m)
 
@@ -261,7 +316,11 @@ func resourceClusterRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("diskSizeGB", cluster.DiskSizeGB)
 	d.Set("mongoDBMajorVersion", cluster.MongoDBMajorVersion)
 	d.Set("mongoDBVersion", cluster.MongoDBVersion)
+	d.Set("mongoURI", cluster.MongoURI)
 	d.Set("mongoURIUpdated", cluster.MongoURIUpdated)
+	d.Set("mongoURIWithOptions", cluster.MongoURIWithOptions)
+	d.Set("srvAddress", cluster.SrvAddress)
+	setClusterConnectionStrings(d, cluster.ConnectionStrings)
 	d.Set("numShards", cluster.NumShards)
 	d.Set("providerName", cluster.ProviderSettings.ProviderName)
 	d.Set("diskIOPS", cluster.ProviderSettings.DiskIOPS)
@@ -270,9 +329,41 @@ func resourceClusterRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("regionName", cluster.ProviderSettings.RegionName)
 	d.Set("replicationFactor", cluster.ReplicationFactor)
 	d.Set("stateName", cluster.StateName)
+	d.Set("encryptionAtRestProvider", cluster.EncryptionAtRestProvider)
+	return nil
+}
+
+// checkEncryptionAtRestProvider refuses to use a key management service that is
+// not configured on the project, Atlas would otherwise accept the cluster and
+// fail it later on.
+func checkEncryptionAtRestProvider(client *MongoatlasClient, groupId string, provider string) error {
+	if provider == "" || provider == "NONE" {
+		return nil
+	}
+
+	encryptionatrest, err := getEncryptionAtRest(client, groupId)
+	if err != nil {
+		return err
+	}
+
+	if !encryptionAtRestEnabled(encryptionatrest, provider) {
+		return fmt.Errorf("encryptionAtRestProvider is %s but encryption at rest with %s is not enabled on group %s, see mongoatlas_encryption_at_rest", provider, provider, groupId)
+	}
 	return nil
 }
 
+// setClusterConnectionStrings stores the connectionStrings document returned by
+// Atlas. Clusters created before the document existed return none at all.
+func setClusterConnectionStrings(d *schema.ResourceData, connectionStrings *ConnectionStrings) {
+	if connectionStrings == nil {
+		connectionStrings = &ConnectionStrings{}
+	}
+	d.Set("connectionStringStandard", connectionStrings.Standard)
+	d.Set("connectionStringStandardSrv", connectionStrings.StandardSrv)
+	d.Set("connectionStringPrivate", connectionStrings.Private)
+	d.Set("connectionStringPrivateSrv", connectionStrings.PrivateSrv)
+}
+
 func resourceClusterUpdate(d *schema.ResourceData, m interface{}) error {
 	setProvider := false
 	client := m.(*MongoatlasClient)
@@ -302,6 +393,13 @@ func resourceClusterUpdate(d *schema.ResourceData, m interface{}) error {
 		cluster.ReplicationFactor = d.Get("replicationFactor").(int)
 	}
 
+	if d.HasChange("encryptionAtRestProvider") {
+		cluster.EncryptionAtRestProvider = d.Get("encryptionAtRestProvider").(string)
+		if err := checkEncryptionAtRestProvider(client, d.Get("groupId").(string), cluster.EncryptionAtRestProvider); err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("regionName") {
 		if !setProvider {
 			setProvider = true
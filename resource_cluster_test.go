@@ -48,6 +48,10 @@ func TestAccMongoatlasCluster_basic(t *testing.T) {
 				Config: testAccMongoatlasClusterConfig,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckMongoatlasClusterExists("mongoatlas_cluster.acceptancetest_cluster", &cluster),
+					resource.TestCheckResourceAttrSet(
+						"mongoatlas_cluster.acceptancetest_cluster", "mongoURI"),
+					resource.TestCheckResourceAttrSet(
+						"mongoatlas_cluster.acceptancetest_cluster", "connectionStringStandard"),
 				),
 			},
 
@@ -318,6 +322,42 @@ func TestAccMongoAtlasClusterReplicationFactor_validation(t *testing.T) {
 	}
 }
 
+func TestAccMongoAtlasClusterEncryptionAtRestProvider_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "NONE",
+			ErrCount: 0,
+		},
+		{
+			Value:    "AWS",
+			ErrCount: 0,
+		},
+		{
+			Value:    "AZURE",
+			ErrCount: 0,
+		},
+		{
+			Value:    "GCP",
+			ErrCount: 0,
+		},
+		{
+			Value:    "aws",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateEncryptionAtRestProvider(tc.Value, "mongoatlas_cluster_encryptionatrestprovider")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %+v Validation Error, Got %+v Validation Error for %+v VALUE", tc.ErrCount, len(errors), tc.Value)
+		}
+	}
+}
+
 func testAccCheckMongoatlasClusterExists(n string, cluster *Cluster) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
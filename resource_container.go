@@ -1,5 +1,3 @@
-// da rivedere, non c'e' la delete sulle API
-
 package main
 
 import (
@@ -9,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"log"
 	"io/ioutil"
+	"strings"
 )
 
 type Container struct {
@@ -18,7 +17,7 @@ type Container struct {
 	ProviderName   string `json:"providerName,omitempty"`
 	RegionName     string `json:"regionName,omitempty"`
 	VpcId          string `json:"vpcId,omitempty"`
-	IsProvisioned    string `json:"isProvisioned,omitempty"`
+	IsProvisioned  bool   `json:"isProvisioned,omitempty"`
 }
 
 func resourceContainer() *schema.Resource {
@@ -32,17 +31,16 @@ func resourceContainer() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"groupId": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-			},
+			"groupId": groupIdSchema(),
 			"atlasCidrBlock": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAtlasCidrBlock,
 			},
 			"providerName": &schema.Schema{
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			},
 			"regionName": &schema.Schema{
 				Type:     schema.TypeString,
@@ -88,6 +86,9 @@ func resourceContainerCreate(d *schema.ResourceData, m interface{}) error {
 	container_req, err := client.Post(fmt.Sprintf("groups/%s/containers",
 		d.Get("groupId").(string),
 	), jsonpayload)
+	if err != nil {
+		return err
+	}
 
 	if container_req.StatusCode != 201 {
 		body, err := ioutil.ReadAll(container_req.Body)
@@ -134,6 +135,12 @@ func resourceContainerRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if container_req.StatusCode == 404 {
+		log.Printf("[DEBUG] container %s no longer exist, so we'll drop it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
 	var container Container
 
 	decoder := json.NewDecoder(container_req.Body)
@@ -153,9 +160,123 @@ func resourceContainerRead(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceContainerUpdate(d *schema.ResourceData, m interface{}) error {
-	return resourceContainerCreate(d, m)
+	client := m.(*MongoatlasClient)
+
+	// Atlas wants the provider on every patch, it only accepts the change while
+	// no cluster is deployed in the container
+	container := &Container{
+		ProviderName: d.Get("providerName").(string),
+	}
+
+	if d.HasChange("atlasCidrBlock") {
+		container.AtlasCidrBlock = d.Get("atlasCidrBlock").(string)
+	}
+	if d.HasChange("regionName") {
+		container.RegionName = d.Get("regionName").(string)
+	}
+
+	var jsonbuffer []byte
+
+	jsonpayload := bytes.NewBuffer(jsonbuffer)
+	enc := json.NewEncoder(jsonpayload)
+	enc.Encode(container)
+
+	log.Printf("Sending %s \n", jsonpayload)
+
+	container_req, err := client.Patch(fmt.Sprintf("groups/%s/containers/%s",
+		d.Get("groupId").(string),
+		d.Id(),
+	), jsonpayload)
+	if err != nil {
+		return err
+	}
+
+	if container_req.StatusCode != 200 {
+		body, err := ioutil.ReadAll(container_req.Body)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("Failed to update container %s. Got the following response body %s", d.Id(), string(body))
+	}
+
+	return resourceContainerRead(d, m)
+}
+
+// containerUsers lists the clusters and peers still deployed in a container.
+// Clusters do not reference their container, they are matched on provider and
+// region instead, GCP containers span every region.
+func containerUsers(client *MongoatlasClient, groupId string, id string, providerName string, regionName string) ([]string, error) {
+	users := []string{}
+
+	clusters, err := client.GetAll(fmt.Sprintf("groups/%s/clusters", groupId))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, raw := range clusters {
+		var cluster Cluster
+		if err := json.Unmarshal(raw, &cluster); err != nil {
+			return nil, err
+		}
+
+		if cluster.ProviderSettings == nil || cluster.ProviderSettings.ProviderName != providerName {
+			continue
+		}
+		if providerName == "GCP" || cluster.ProviderSettings.RegionName == regionName {
+			users = append(users, fmt.Sprintf("cluster %s", cluster.Name))
+		}
+	}
+
+	peers, err := client.GetAll(fmt.Sprintf("groups/%s/peers?providerName=%s", groupId, providerName))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, raw := range peers {
+		var vpcpeering VpcPeering
+		if err := json.Unmarshal(raw, &vpcpeering); err != nil {
+			return nil, err
+		}
+
+		if vpcpeering.ContainerId == id {
+			users = append(users, fmt.Sprintf("peer %s", vpcpeering.Id))
+		}
+	}
+
+	return users, nil
 }
 
 func resourceContainerDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	users, err := containerUsers(client,
+		d.Get("groupId").(string),
+		d.Id(),
+		d.Get("providerName").(string),
+		d.Get("regionName").(string),
+	)
+	if err != nil {
+		return err
+	}
+
+	if len(users) > 0 {
+		return fmt.Errorf("Container %s is still used by %s, delete them first", d.Id(), strings.Join(users, ", "))
+	}
+
+	delete_response, err := client.Delete(fmt.Sprintf("groups/%s/containers/%s",
+		d.Get("groupId").(string),
+		d.Id(),
+	))
+	if err != nil {
+		return err
+	}
+
+	if delete_response.StatusCode != 200 && delete_response.StatusCode != 202 && delete_response.StatusCode != 204 && delete_response.StatusCode != 404 {
+		body, err := ioutil.ReadAll(delete_response.Body)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("Failed to delete container %s. Got the following response body %s", d.Id(), string(body))
+	}
 	return nil
 }
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccMongoatlasContainer_basic(t *testing.T) {
+	testGroupId := os.Getenv("MONGOATLAS_GROUPID")
+
+	testAccMongoatlasContainerConfig := func(atlasCidrBlock string) string {
+		return fmt.Sprintf(
+			`resource "mongoatlas_container" "acceptancetest_container" {
+				groupId = "%s"
+				atlasCidrBlock = "%s"
+				providerName = "AWS"
+				regionName = "US_WEST_2"
+			}
+		`, testGroupId, atlasCidrBlock)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoatlasContainerDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccMongoatlasContainerConfig("192.168.240.0/21"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoatlasContainerExists("mongoatlas_container.acceptancetest_container"),
+					resource.TestCheckResourceAttr(
+						"mongoatlas_container.acceptancetest_container", "atlasCidrBlock", "192.168.240.0/21"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccMongoatlasContainerConfig("192.168.232.0/21"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoatlasContainerExists("mongoatlas_container.acceptancetest_container"),
+					resource.TestCheckResourceAttr(
+						"mongoatlas_container.acceptancetest_container", "atlasCidrBlock", "192.168.232.0/21"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccCheckMongoatlasContainerDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*MongoatlasClient)
+	rs, ok := s.RootModule().Resources["mongoatlas_container.acceptancetest_container"]
+	if !ok {
+		return fmt.Errorf("Not found %s", "mongoatlas_container.acceptancetest_container")
+	}
+
+	response, err := client.Get(fmt.Sprintf("groups/%s/containers/%s", rs.Primary.Attributes["groupId"], rs.Primary.ID))
+
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode != 404 {
+		return fmt.Errorf("Container still exists")
+	}
+
+	return nil
+}
+
+func testAccCheckMongoatlasContainerExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No container ID is set")
+		}
+		return nil
+	}
+}
+
+func TestAccMongoAtlasContainerAtlasCidrBlock_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "192.168.248.0/21",
+			ErrCount: 0,
+		},
+		{
+			Value:    "10.8.0.0/18",
+			ErrCount: 0,
+		},
+		{
+			Value:    "172.31.192.0/19",
+			ErrCount: 0,
+		},
+		{
+			Value:    "10.8.0.0/16",
+			ErrCount: 1,
+		},
+		{
+			Value:    "10.8.0.0/24",
+			ErrCount: 1,
+		},
+		{
+			Value:    "52.8.0.0/21",
+			ErrCount: 1,
+		},
+		{
+			Value:    "10.8.1.0/21",
+			ErrCount: 1,
+		},
+		{
+			Value:    "10.8.0.0",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateAtlasCidrBlock(tc.Value, "mongoatlas_container_atlascidrblock")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %+v Validation Error, Got %+v Validation Error for %+v VALUE", tc.ErrCount, len(errors), tc.Value)
+		}
+	}
+}
@@ -7,13 +7,20 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"log"
 	"io/ioutil"
+	"net/url"
+	"strings"
 )
 
 type DatabaseUser struct {
-	DatabaseName string  `json:"databaseName,omitempty"`
-	Username     string  `json:"username,omitempty"`
-	Roles        *[]Role `json:"roles,omitempty"` // check if this is right or not
-	Password     string  `json:"password,omitempty"`
+	DatabaseName string   `json:"databaseName,omitempty"`
+	Username     string   `json:"username,omitempty"`
+	Roles        *[]Role  `json:"roles,omitempty"` // check if this is right or not
+	Password     string   `json:"password,omitempty"`
+	Scopes       *[]Scope `json:"scopes,omitempty"`
+	X509Type     string   `json:"x509Type,omitempty"`
+	LdapAuthType string   `json:"ldapAuthType,omitempty"`
+	AwsIAMType   string   `json:"awsIAMType,omitempty"`
+	Labels       *[]Label `json:"labels,omitempty"`
 }
 
 type Role struct {
@@ -21,25 +28,42 @@ type Role struct {
 	RoleName     string `json:"roleName,omitempty"`
 }
 
+type Scope struct {
+	Name string `json:"name,omitempty"`
+	Type string `json:"type,omitempty"`
+}
+
+type Label struct {
+	Key   string `json:"key,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+// databaseUserAuthTypes are the arguments switching a user from a password to
+// an external authentication mechanism, NONE meaning unused
+var databaseUserAuthTypes = []string{"x509Type", "ldapAuthType", "awsIAMType"}
+
 //TODO: need to write validation for rolename
 func resourceDatabaseUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceDatabaseUserCreate,
-		Update: resourceDatabaseUserUpdate,
-		Read:   resourceDatabaseUserRead,
-		Delete: resourceDatabaseUserDelete,
+		Create:        resourceDatabaseUserCreate,
+		Update:        resourceDatabaseUserUpdate,
+		Read:          resourceDatabaseUserRead,
+		Delete:        resourceDatabaseUserDelete,
+		CustomizeDiff: resourceDatabaseUserCustomizeDiff,
 		Schema: map[string]*schema.Schema{
-			"groupId": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-			},
+			"groupId": groupIdSchema(),
+			// the authentication database, $external for X.509, LDAP users and AWS IAM
+			// users, admin for LDAP groups
 			"databaseName": &schema.Schema{
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			},
+			// the endpoint addresses users by name, so renaming one replaces it
 			"username": &schema.Schema{
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			},
 			"roles": &schema.Schema{
 				Type:     schema.TypeList,
@@ -58,15 +82,159 @@ func resourceDatabaseUser() *schema.Resource {
 					},
 				},
 			},
+			// not used by users relying on external authentication
 			"password": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:      schema.TypeString,
+				Optional:  true,
 				Sensitive: true,
 			},
+			"x509Type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "NONE",
+				ValidateFunc: validateX509Type,
+			},
+			// USER maps the username to an LDAP DN, GROUP to an LDAP group
+			"ldapAuthType": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "NONE",
+				ValidateFunc: validateLdapAuthType,
+			},
+			// the username is then the ARN of the IAM user or role
+			"awsIAMType": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "NONE",
+				ValidateFunc: validateAwsIAMType,
+			},
+			// clusters and data lakes the user is restricted to, all of them when empty
+			"scopes": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "CLUSTER",
+							ValidateFunc: validateScopeType,
+						},
+					},
+				},
+			},
+			"labels": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// databaseUserExternalAuth returns the authentication arguments set to
+// something else than NONE, it works on both ResourceData and ResourceDiff
+func databaseUserExternalAuth(d interface {
+	Get(string) interface{}
+}) []string {
+	authTypes := []string{}
+	for _, authType := range databaseUserAuthTypes {
+		if value, ok := d.Get(authType).(string); ok && value != "" && value != "NONE" {
+			authTypes = append(authTypes, authType)
+		}
+	}
+	return authTypes
+}
+
+// resourceDatabaseUserCustomizeDiff checks the authentication arguments fit
+// together. A password left unknown until apply is checked on create instead.
+func resourceDatabaseUserCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	authTypes := databaseUserExternalAuth(d)
+
+	if len(authTypes) > 1 {
+		return fmt.Errorf("Only one of %s can be set to something else than NONE", strings.Join(authTypes, ", "))
+	}
+
+	if len(authTypes) == 1 {
+		if _, ok := d.GetOk("password"); ok {
+			return fmt.Errorf("password cannot be set on users authenticated with %s", authTypes[0])
+		}
+		expected := databaseUserAuthDatabase(d)
+		if databaseName, ok := d.GetOk("databaseName"); ok && databaseName.(string) != expected {
+			return fmt.Errorf("databaseName must be %s for users authenticated with %s", expected, authTypes[0])
+		}
+		if username, ok := d.GetOk("username"); ok && authTypes[0] == "awsIAMType" && !strings.HasPrefix(username.(string), "arn:aws:iam::") {
+			return fmt.Errorf("username must be the ARN of the IAM %s for users authenticated with awsIAMType", strings.ToLower(d.Get("awsIAMType").(string)))
+		}
+	}
+
+	return nil
+}
+
+// databaseUserAuthDatabase returns the authentication database Atlas expects
+// for an external user, LDAP groups are the only ones living in admin
+func databaseUserAuthDatabase(d interface {
+	Get(string) interface{}
+}) string {
+	if d.Get("ldapAuthType").(string) == "GROUP" {
+		return "admin"
+	}
+	return "$external"
+}
+
+// databaseUserEndpoint addresses the user in its authentication database, the
+// names are escaped since usernames can hold slashes
+func databaseUserEndpoint(d *schema.ResourceData) string {
+	return fmt.Sprintf("groups/%s/databaseUsers/%s/%s",
+		d.Get("groupId").(string),
+		url.PathEscape(d.Get("databaseName").(string)),
+		url.PathEscape(d.Get("username").(string)),
+	)
+}
+
+// expandScopes always returns a slice, an empty one lifts every restriction
+func expandScopes(d *schema.ResourceData) *[]Scope {
+	scopes := []Scope{}
+	for _, raw := range d.Get("scopes").(*schema.Set).List() {
+		scope := raw.(map[string]interface{})
+		scopes = append(scopes, Scope{
+			Name: scope["name"].(string),
+			Type: scope["type"].(string),
+		})
+	}
+	return &scopes
+}
+
+func expandLabels(d *schema.ResourceData) *[]Label {
+	labels := []Label{}
+	for _, raw := range d.Get("labels").(*schema.Set).List() {
+		label := raw.(map[string]interface{})
+		labels = append(labels, Label{
+			Key:   label["key"].(string),
+			Value: label["value"].(string),
+		})
+	}
+	return &labels
+}
+
 func newDatabaseUser(d *schema.ResourceData) *DatabaseUser {
 
 	var roles []Role
@@ -87,6 +255,11 @@ func newDatabaseUser(d *schema.ResourceData) *DatabaseUser {
 		Username:     d.Get("username").(string),
 		Roles:        &roles,
 		Password:     d.Get("password").(string),
+		X509Type:     d.Get("x509Type").(string),
+		LdapAuthType: d.Get("ldapAuthType").(string),
+		AwsIAMType:   d.Get("awsIAMType").(string),
+		Scopes:       expandScopes(d),
+		Labels:       expandLabels(d),
 	}
 
 	return databaseuser
@@ -97,6 +270,10 @@ func resourceDatabaseUserCreate(d *schema.ResourceData, m interface{}) error {
 	// Instantiate the MongoatlasClient. Source in client.go, it handles all the HTTP REST API communication
 	client := m.(*MongoatlasClient)
 
+	if len(databaseUserExternalAuth(d)) == 0 && d.Get("password").(string) == "" {
+		return fmt.Errorf("password is required for users without external authentication")
+	}
+
 	databaseuser := newDatabaseUser(d)
 
 	var jsonbuffer []byte
@@ -163,15 +340,18 @@ func resourceDatabaseUserRead(d *schema.ResourceData, m interface{}) error {
 	log.Printf("%s", d.Get("username").(string))
 	log.Printf("%s", d.Get("groupId").(string))
 
-	databaseuser_req, err := client.Get(fmt.Sprintf("groups/%s/databaseUsers/admin/%s",
-		d.Get("groupId").(string),
-		d.Get("username").(string),
-	))
+	databaseuser_req, err := client.Get(databaseUserEndpoint(d))
 
 	if err != nil {
 		return err
 	}
 
+	if databaseuser_req.StatusCode == 404 {
+		log.Printf("[DEBUG] database user %s no longer exist, so we'll drop it from the state", d.Get("username").(string))
+		d.SetId("")
+		return nil
+	}
+
 	var databaseuser DatabaseUser
 
 	decoder := json.NewDecoder(databaseuser_req.Body)
@@ -183,6 +363,16 @@ func resourceDatabaseUserRead(d *schema.ResourceData, m interface{}) error {
 
 	d.Set("username", databaseuser.Username)
 	d.Set("databaseName", databaseuser.DatabaseName)
+	// older users come back without the authentication types, which means NONE
+	if databaseuser.X509Type != "" {
+		d.Set("x509Type", databaseuser.X509Type)
+	}
+	if databaseuser.LdapAuthType != "" {
+		d.Set("ldapAuthType", databaseuser.LdapAuthType)
+	}
+	if databaseuser.AwsIAMType != "" {
+		d.Set("awsIAMType", databaseuser.AwsIAMType)
+	}
 
 	var s []map[string]interface{}
 	for _, t := range *databaseuser.Roles {
@@ -198,6 +388,32 @@ func resourceDatabaseUserRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	scopes := []map[string]interface{}{}
+	if databaseuser.Scopes != nil {
+		for _, t := range *databaseuser.Scopes {
+			scopes = append(scopes, map[string]interface{}{
+				"name": t.Name,
+				"type": t.Type,
+			})
+		}
+	}
+	if err := d.Set("scopes", scopes); err != nil {
+		return err
+	}
+
+	labels := []map[string]interface{}{}
+	if databaseuser.Labels != nil {
+		for _, t := range *databaseuser.Labels {
+			labels = append(labels, map[string]interface{}{
+				"key":   t.Key,
+				"value": t.Value,
+			})
+		}
+	}
+	if err := d.Set("labels", labels); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -224,6 +440,14 @@ func resourceDatabaseUserUpdate(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
+	if d.HasChange("scopes") {
+		databaseuser.Scopes = expandScopes(d)
+	}
+
+	if d.HasChange("labels") {
+		databaseuser.Labels = expandLabels(d)
+	}
+
 	
 	var jsonbuffer []byte
 
@@ -233,10 +457,7 @@ func resourceDatabaseUserUpdate(d *schema.ResourceData, m interface{}) error {
 
 	log.Printf("Sending %s \n", jsonpayload)
 
-	databaseuser_req, err := client.Patch(fmt.Sprintf("groups/%s/databaseUsers/admin/%s",
-		d.Get("groupId").(string),
-		d.Get("username").(string),
-	), jsonpayload)
+	databaseuser_req, err := client.Patch(databaseUserEndpoint(d), jsonpayload)
 
 	if err != nil {
 		return err
@@ -263,10 +484,7 @@ func resourceDatabaseUserDelete(d *schema.ResourceData, m interface{}) error {
 
 	client := m.(*MongoatlasClient)
 
-	delete_response, err := client.Delete(fmt.Sprintf("groups/%s/databaseUsers/admin/%s",
-		d.Get("groupId"),
-		d.Get("username"),
-	))
+	delete_response, err := client.Delete(databaseUserEndpoint(d))
 
 	if err != nil {
 		return err
@@ -2,10 +2,13 @@ package main
 
 import (
 	"fmt"
+	"net/url"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -71,19 +74,20 @@ func TestAccMongoatlasDatabaseUser_basic(t *testing.T) {
 
 func testAccCheckMongoatlasDatabaseUserDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*MongoatlasClient)
-	rs, ok := s.RootModule().Resources["mongoatlas_database_user.acceptancetest_databaseuser"]
-	if !ok {
-		return fmt.Errorf("Not found %s", "mongoatlas_database_user.acceptancetest_databaseuser")
-	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mongoatlas_database_user" {
+			continue
+		}
 
-	response, err := client.Get(fmt.Sprintf("groups/%s/databaseUsers/admin/%s", rs.Primary.Attributes["groupId"], rs.Primary.Attributes["username"]))
+		response, err := client.Get(fmt.Sprintf("groups/%s/databaseUsers/%s/%s", rs.Primary.Attributes["groupId"], url.PathEscape(rs.Primary.Attributes["databaseName"]), url.PathEscape(rs.Primary.Attributes["username"])))
 
-	if err != nil {
-		return err
-	}
+		if err != nil {
+			return err
+		}
 
-	if response.StatusCode != 404 {
-		return fmt.Errorf("DatabaseUser still exists")
+		if response.StatusCode != 404 {
+			return fmt.Errorf("DatabaseUser still exists")
+		}
 	}
 
 	return nil
@@ -101,3 +105,273 @@ func testAccCheckMongoatlasDatabaseUserExists(n string, databaseuser *DatabaseUs
 		return nil
 	}
 }
+
+func TestAccMongoAtlasDatabaseUserEndpoint(t *testing.T) {
+	cases := []struct {
+		DatabaseName string
+		Username     string
+		Endpoint     string
+	}{
+		{
+			DatabaseName: "admin",
+			Username:     "acctest",
+			Endpoint:     "groups/0123456789/databaseUsers/admin/acctest",
+		},
+		{
+			DatabaseName: "$external",
+			Username:     "acctest",
+			Endpoint:     "groups/0123456789/databaseUsers/$external/acctest",
+		},
+		{
+			DatabaseName: "admin",
+			Username:     "team/acctest",
+			Endpoint:     "groups/0123456789/databaseUsers/admin/team%2Facctest",
+		},
+		{
+			DatabaseName: "$external",
+			Username:     "arn:aws:iam::123456789012:role/acctest",
+			Endpoint:     "groups/0123456789/databaseUsers/$external/arn:aws:iam::123456789012:role%2Facctest",
+		},
+	}
+
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, resourceDatabaseUser().Schema, map[string]interface{}{
+			"groupId":      "0123456789",
+			"databaseName": tc.DatabaseName,
+			"username":     tc.Username,
+		})
+
+		if endpoint := databaseUserEndpoint(d); endpoint != tc.Endpoint {
+			t.Fatalf("Expected endpoint %s, Got %s", tc.Endpoint, endpoint)
+		}
+	}
+}
+
+func TestAccMongoAtlasDatabaseUserX509Type_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "NONE",
+			ErrCount: 0,
+		},
+		{
+			Value:    "MANAGED",
+			ErrCount: 0,
+		},
+		{
+			Value:    "CUSTOMER",
+			ErrCount: 0,
+		},
+		{
+			Value:    "managed",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateX509Type(tc.Value, "mongoatlas_database_user_x509type")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %+v Validation Error, Got %+v Validation Error for %+v VALUE", tc.ErrCount, len(errors), tc.Value)
+		}
+	}
+}
+
+func TestAccMongoAtlasDatabaseUserLdapAuthType_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "NONE",
+			ErrCount: 0,
+		},
+		{
+			Value:    "USER",
+			ErrCount: 0,
+		},
+		{
+			Value:    "GROUP",
+			ErrCount: 0,
+		},
+		{
+			Value:    "group",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateLdapAuthType(tc.Value, "mongoatlas_database_user_ldapauthtype")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %+v Validation Error, Got %+v Validation Error for %+v VALUE", tc.ErrCount, len(errors), tc.Value)
+		}
+	}
+}
+
+func TestAccMongoAtlasDatabaseUserAuthDatabase(t *testing.T) {
+	cases := []struct {
+		LdapAuthType string
+		DatabaseName string
+	}{
+		{
+			LdapAuthType: "USER",
+			DatabaseName: "$external",
+		},
+		{
+			LdapAuthType: "GROUP",
+			DatabaseName: "admin",
+		},
+	}
+
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, resourceDatabaseUser().Schema, map[string]interface{}{
+			"groupId":      "0123456789",
+			"databaseName": tc.DatabaseName,
+			"username":     "acctest",
+			"ldapAuthType": tc.LdapAuthType,
+		})
+
+		if databaseName := databaseUserAuthDatabase(d); databaseName != tc.DatabaseName {
+			t.Fatalf("Expected databaseName %s for %s, Got %s", tc.DatabaseName, tc.LdapAuthType, databaseName)
+		}
+	}
+}
+
+func TestAccMongoatlasDatabaseUser_awsIAM(t *testing.T) {
+	testGroupId := os.Getenv("MONGOATLAS_GROUPID")
+
+	testAccMongoatlasDatabaseUserConfig_awsIAM := func(username string) string {
+		return fmt.Sprintf(
+			`resource "mongoatlas_database_user" "acceptancetest_iamuser" {
+				databaseName = "$external"
+				username = "%s"
+				awsIAMType = "ROLE"
+				roles = [
+					{
+						databaseName = "admin"
+						roleName = "read"
+					}
+				]
+				groupId = "%s"
+			}
+		`, username, testGroupId)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoatlasDatabaseUserDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccMongoatlasDatabaseUserConfig_awsIAM("acctest"),
+				ExpectError: regexp.MustCompile("username must be the ARN"),
+			},
+			resource.TestStep{
+				Config: testAccMongoatlasDatabaseUserConfig_awsIAM("arn:aws:iam::123456789012:role/acctest"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"mongoatlas_database_user.acceptancetest_iamuser", "awsIAMType", "ROLE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMongoAtlasDatabaseUserAwsIAMType_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "NONE",
+			ErrCount: 0,
+		},
+		{
+			Value:    "USER",
+			ErrCount: 0,
+		},
+		{
+			Value:    "ROLE",
+			ErrCount: 0,
+		},
+		{
+			Value:    "GROUP",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateAwsIAMType(tc.Value, "mongoatlas_database_user_awsiamtype")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %+v Validation Error, Got %+v Validation Error for %+v VALUE", tc.ErrCount, len(errors), tc.Value)
+		}
+	}
+}
+
+func TestAccMongoAtlasDatabaseUserScopeType_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "CLUSTER",
+			ErrCount: 0,
+		},
+		{
+			Value:    "DATA_LAKE",
+			ErrCount: 0,
+		},
+		{
+			Value:    "cluster",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateScopeType(tc.Value, "mongoatlas_database_user_scopes_type")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %+v Validation Error, Got %+v Validation Error for %+v VALUE", tc.ErrCount, len(errors), tc.Value)
+		}
+	}
+}
+
+func TestAccMongoAtlasDatabaseUserScopesAndLabels(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceDatabaseUser().Schema, map[string]interface{}{
+		"groupId":      "0123456789",
+		"databaseName": "admin",
+		"username":     "acctest",
+		"scopes": []interface{}{
+			map[string]interface{}{"name": "acctest-cluster"},
+		},
+		"labels": []interface{}{
+			map[string]interface{}{"key": "team", "value": "acctest"},
+		},
+	})
+
+	databaseuser := newDatabaseUser(d)
+
+	scopes := *databaseuser.Scopes
+	if len(scopes) != 1 || scopes[0].Name != "acctest-cluster" || scopes[0].Type != "CLUSTER" {
+		t.Fatalf("Expected scope acctest-cluster of type CLUSTER, Got %+v", scopes)
+	}
+
+	labels := *databaseuser.Labels
+	if len(labels) != 1 || labels[0].Key != "team" || labels[0].Value != "acctest" {
+		t.Fatalf("Expected label team=acctest, Got %+v", labels)
+	}
+
+	// no scopes still has to be sent, so Atlas lifts the restrictions
+	d = schema.TestResourceDataRaw(t, resourceDatabaseUser().Schema, map[string]interface{}{
+		"groupId":      "0123456789",
+		"databaseName": "admin",
+		"username":     "acctest",
+	})
+	if scopes := expandScopes(d); scopes == nil || len(*scopes) != 0 {
+		t.Fatalf("Expected an empty list of scopes, Got %+v", scopes)
+	}
+}
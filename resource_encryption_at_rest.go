@@ -0,0 +1,354 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"io/ioutil"
+	"log"
+)
+
+type EncryptionAtRest struct {
+	AwsKms         *AwsKms         `json:"awsKms,omitempty"`
+	AzureKeyVault  *AzureKeyVault  `json:"azureKeyVault,omitempty"`
+	GoogleCloudKms *GoogleCloudKms `json:"googleCloudKms,omitempty"`
+}
+
+type AwsKms struct {
+	Enabled             *bool  `json:"enabled,omitempty"`
+	AccessKeyID         string `json:"accessKeyID,omitempty"`
+	SecretAccessKey     string `json:"secretAccessKey,omitempty"`
+	CustomerMasterKeyID string `json:"customerMasterKeyID,omitempty"`
+	Region              string `json:"region,omitempty"`
+	RoleId              string `json:"roleId,omitempty"`
+}
+
+type AzureKeyVault struct {
+	Enabled           *bool  `json:"enabled,omitempty"`
+	ClientID          string `json:"clientID,omitempty"`
+	AzureEnvironment  string `json:"azureEnvironment,omitempty"`
+	SubscriptionID    string `json:"subscriptionID,omitempty"`
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+	KeyVaultName      string `json:"keyVaultName,omitempty"`
+	KeyIdentifier     string `json:"keyIdentifier,omitempty"`
+	Secret            string `json:"secret,omitempty"`
+	TenantID          string `json:"tenantID,omitempty"`
+}
+
+type GoogleCloudKms struct {
+	Enabled              *bool  `json:"enabled,omitempty"`
+	ServiceAccountKey    string `json:"serviceAccountKey,omitempty"`
+	KeyVersionResourceID string `json:"keyVersionResourceID,omitempty"`
+}
+
+func resourceEncryptionAtRest() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEncryptionAtRestCreate,
+		Update: resourceEncryptionAtRestUpdate,
+		Read:   resourceEncryptionAtRestRead,
+		Delete: resourceEncryptionAtRestDelete,
+		Schema: map[string]*schema.Schema{
+			"groupId": groupIdSchema(),
+			"awsKms": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"accessKeyID": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"secretAccessKey": &schema.Schema{
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"customerMasterKeyID": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"region": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"roleId": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"azureKeyVault": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"clientID": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"azureEnvironment": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "AZURE",
+						},
+						"subscriptionID": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"resourceGroupName": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"keyVaultName": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"keyIdentifier": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"secret": &schema.Schema{
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+						"tenantID": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"googleCloudKms": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"serviceAccountKey": &schema.Schema{
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+						"keyVersionResourceID": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newEncryptionAtRest(d *schema.ResourceData) *EncryptionAtRest {
+	// every provider is sent, the ones missing from the configuration disabled
+	encryptionatrest := &EncryptionAtRest{
+		AwsKms:         &AwsKms{Enabled: new(bool)},
+		AzureKeyVault:  &AzureKeyVault{Enabled: new(bool)},
+		GoogleCloudKms: &GoogleCloudKms{Enabled: new(bool)},
+	}
+
+	if l := d.Get("awsKms").([]interface{}); len(l) > 0 {
+		awsKmsMap := l[0].(map[string]interface{})
+		*encryptionatrest.AwsKms.Enabled = awsKmsMap["enabled"].(bool)
+		encryptionatrest.AwsKms.AccessKeyID = awsKmsMap["accessKeyID"].(string)
+		encryptionatrest.AwsKms.SecretAccessKey = awsKmsMap["secretAccessKey"].(string)
+		encryptionatrest.AwsKms.CustomerMasterKeyID = awsKmsMap["customerMasterKeyID"].(string)
+		encryptionatrest.AwsKms.Region = awsKmsMap["region"].(string)
+		encryptionatrest.AwsKms.RoleId = awsKmsMap["roleId"].(string)
+	}
+
+	if l := d.Get("azureKeyVault").([]interface{}); len(l) > 0 {
+		azureKeyVaultMap := l[0].(map[string]interface{})
+		*encryptionatrest.AzureKeyVault.Enabled = azureKeyVaultMap["enabled"].(bool)
+		encryptionatrest.AzureKeyVault.ClientID = azureKeyVaultMap["clientID"].(string)
+		encryptionatrest.AzureKeyVault.AzureEnvironment = azureKeyVaultMap["azureEnvironment"].(string)
+		encryptionatrest.AzureKeyVault.SubscriptionID = azureKeyVaultMap["subscriptionID"].(string)
+		encryptionatrest.AzureKeyVault.ResourceGroupName = azureKeyVaultMap["resourceGroupName"].(string)
+		encryptionatrest.AzureKeyVault.KeyVaultName = azureKeyVaultMap["keyVaultName"].(string)
+		encryptionatrest.AzureKeyVault.KeyIdentifier = azureKeyVaultMap["keyIdentifier"].(string)
+		encryptionatrest.AzureKeyVault.Secret = azureKeyVaultMap["secret"].(string)
+		encryptionatrest.AzureKeyVault.TenantID = azureKeyVaultMap["tenantID"].(string)
+	}
+
+	if l := d.Get("googleCloudKms").([]interface{}); len(l) > 0 {
+		googleCloudKmsMap := l[0].(map[string]interface{})
+		*encryptionatrest.GoogleCloudKms.Enabled = googleCloudKmsMap["enabled"].(bool)
+		encryptionatrest.GoogleCloudKms.ServiceAccountKey = googleCloudKmsMap["serviceAccountKey"].(string)
+		encryptionatrest.GoogleCloudKms.KeyVersionResourceID = googleCloudKmsMap["keyVersionResourceID"].(string)
+	}
+
+	return encryptionatrest
+}
+
+func patchEncryptionAtRest(d *schema.ResourceData, m interface{}, encryptionatrest *EncryptionAtRest) error {
+	client := m.(*MongoatlasClient)
+
+	var jsonbuffer []byte
+
+	jsonpayload := bytes.NewBuffer(jsonbuffer)
+	enc := json.NewEncoder(jsonpayload)
+	enc.Encode(encryptionatrest)
+
+	// the payload carries the key management credentials, so it is not logged
+	log.Printf("Configuring encryption at rest for group %s \n", d.Get("groupId").(string))
+
+	encryptionatrest_req, err := client.Patch(fmt.Sprintf("groups/%s/encryptionAtRest",
+		d.Get("groupId").(string),
+	), jsonpayload)
+	if err != nil {
+		return err
+	}
+
+	if encryptionatrest_req.StatusCode != 200 {
+		body, err := ioutil.ReadAll(encryptionatrest_req.Body)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("Failed to patch encryption at rest: %s", string(body))
+	}
+
+	return nil
+}
+
+func getEncryptionAtRest(client *MongoatlasClient, groupId string) (*EncryptionAtRest, error) {
+	encryptionatrest_req, err := client.Get(fmt.Sprintf("groups/%s/encryptionAtRest", groupId))
+	if err != nil {
+		return nil, err
+	}
+
+	if encryptionatrest_req.StatusCode != 200 {
+		body, err := ioutil.ReadAll(encryptionatrest_req.Body)
+		if err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("Failed to read encryption at rest. Got the following response body %s", string(body))
+	}
+
+	var encryptionatrest EncryptionAtRest
+
+	decoder := json.NewDecoder(encryptionatrest_req.Body)
+	err = decoder.Decode(&encryptionatrest)
+	if err != nil {
+		return nil, err
+	}
+
+	return &encryptionatrest, nil
+}
+
+// encryptionAtRestEnabled tells whether the key management service backing
+// a cluster encryptionAtRestProvider is configured and enabled.
+func encryptionAtRestEnabled(encryptionatrest *EncryptionAtRest, provider string) bool {
+	switch provider {
+	case "AWS":
+		return encryptionatrest.AwsKms != nil && encryptionatrest.AwsKms.Enabled != nil && *encryptionatrest.AwsKms.Enabled
+	case "AZURE":
+		return encryptionatrest.AzureKeyVault != nil && encryptionatrest.AzureKeyVault.Enabled != nil && *encryptionatrest.AzureKeyVault.Enabled
+	case "GCP":
+		return encryptionatrest.GoogleCloudKms != nil && encryptionatrest.GoogleCloudKms.Enabled != nil && *encryptionatrest.GoogleCloudKms.Enabled
+	}
+	return true
+}
+
+func resourceEncryptionAtRestCreate(d *schema.ResourceData, m interface{}) error {
+	if err := patchEncryptionAtRest(d, m, newEncryptionAtRest(d)); err != nil {
+		return err
+	}
+
+	d.SetId(d.Get("groupId").(string))
+
+	return resourceEncryptionAtRestRead(d, m)
+}
+
+func resourceEncryptionAtRestRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	encryptionatrest, err := getEncryptionAtRest(client, d.Get("groupId").(string))
+	if err != nil {
+		return err
+	}
+
+	// Atlas never returns the secrets, they are kept from the state
+	var awsKms []map[string]interface{}
+	if t := encryptionatrest.AwsKms; t != nil && (encryptionAtRestEnabled(encryptionatrest, "AWS") || len(d.Get("awsKms").([]interface{})) > 0) {
+		awsKms = append(awsKms, map[string]interface{}{
+			"enabled":             encryptionAtRestEnabled(encryptionatrest, "AWS"),
+			"accessKeyID":         t.AccessKeyID,
+			"secretAccessKey":     d.Get("awsKms.0.secretAccessKey").(string),
+			"customerMasterKeyID": t.CustomerMasterKeyID,
+			"region":              t.Region,
+			"roleId":              t.RoleId,
+		})
+	}
+	if err := d.Set("awsKms", awsKms); err != nil {
+		return err
+	}
+
+	var azureKeyVault []map[string]interface{}
+	if t := encryptionatrest.AzureKeyVault; t != nil && (encryptionAtRestEnabled(encryptionatrest, "AZURE") || len(d.Get("azureKeyVault").([]interface{})) > 0) {
+		azureKeyVault = append(azureKeyVault, map[string]interface{}{
+			"enabled":           encryptionAtRestEnabled(encryptionatrest, "AZURE"),
+			"clientID":          t.ClientID,
+			"azureEnvironment":  t.AzureEnvironment,
+			"subscriptionID":    t.SubscriptionID,
+			"resourceGroupName": t.ResourceGroupName,
+			"keyVaultName":      t.KeyVaultName,
+			"keyIdentifier":     t.KeyIdentifier,
+			"secret":            d.Get("azureKeyVault.0.secret").(string),
+			"tenantID":          t.TenantID,
+		})
+	}
+	if err := d.Set("azureKeyVault", azureKeyVault); err != nil {
+		return err
+	}
+
+	var googleCloudKms []map[string]interface{}
+	if t := encryptionatrest.GoogleCloudKms; t != nil && (encryptionAtRestEnabled(encryptionatrest, "GCP") || len(d.Get("googleCloudKms").([]interface{})) > 0) {
+		googleCloudKms = append(googleCloudKms, map[string]interface{}{
+			"enabled":              encryptionAtRestEnabled(encryptionatrest, "GCP"),
+			"serviceAccountKey":    d.Get("googleCloudKms.0.serviceAccountKey").(string),
+			"keyVersionResourceID": t.KeyVersionResourceID,
+		})
+	}
+	if err := d.Set("googleCloudKms", googleCloudKms); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceEncryptionAtRestUpdate(d *schema.ResourceData, m interface{}) error {
+	if err := patchEncryptionAtRest(d, m, newEncryptionAtRest(d)); err != nil {
+		return err
+	}
+
+	return resourceEncryptionAtRestRead(d, m)
+}
+
+func resourceEncryptionAtRestDelete(d *schema.ResourceData, m interface{}) error {
+	return patchEncryptionAtRest(d, m, &EncryptionAtRest{
+		AwsKms:         &AwsKms{Enabled: new(bool)},
+		AzureKeyVault:  &AzureKeyVault{Enabled: new(bool)},
+		GoogleCloudKms: &GoogleCloudKms{Enabled: new(bool)},
+	})
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccMongoatlasEncryptionAtRest_basic(t *testing.T) {
+	var encryptionatrest EncryptionAtRest
+
+	testGroupId := os.Getenv("MONGOATLAS_GROUPID")
+	testKmsKeyId := os.Getenv("MONGOATLAS_KMS_KEYID")
+	testAwsAccessKeyId := os.Getenv("MONGOATLAS_KMS_ACCESS_KEY_ID")
+	testAwsSecretAccessKey := os.Getenv("MONGOATLAS_KMS_SECRET_ACCESS_KEY")
+
+	testAccMongoatlasEncryptionAtRestConfig := fmt.Sprintf(
+		`resource "mongoatlas_encryption_at_rest" "acceptancetest_encryptionatrest" {
+			groupId = "%s"
+			awsKms {
+				customerMasterKeyID = "%s"
+				accessKeyID = "%s"
+				secretAccessKey = "%s"
+				region = "EU_WEST_1"
+			}
+		}
+	`, testGroupId, testKmsKeyId, testAwsAccessKeyId, testAwsSecretAccessKey)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if testKmsKeyId == "" || testAwsAccessKeyId == "" || testAwsSecretAccessKey == "" {
+				t.Fatal("MONGOATLAS_KMS_KEYID, MONGOATLAS_KMS_ACCESS_KEY_ID and MONGOATLAS_KMS_SECRET_ACCESS_KEY must be set for encryption at rest acceptance tests")
+			}
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoatlasEncryptionAtRestDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccMongoatlasEncryptionAtRestConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoatlasEncryptionAtRestExists("mongoatlas_encryption_at_rest.acceptancetest_encryptionatrest", &encryptionatrest),
+					resource.TestCheckResourceAttr(
+						"mongoatlas_encryption_at_rest.acceptancetest_encryptionatrest", "awsKms.0.enabled", "true"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccCheckMongoatlasEncryptionAtRestDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*MongoatlasClient)
+	rs, ok := s.RootModule().Resources["mongoatlas_encryption_at_rest.acceptancetest_encryptionatrest"]
+	if !ok {
+		return fmt.Errorf("Not found %s", "mongoatlas_encryption_at_rest.acceptancetest_encryptionatrest")
+	}
+
+	encryptionatrest, err := getEncryptionAtRest(client, rs.Primary.Attributes["groupId"])
+	if err != nil {
+		return err
+	}
+
+	if encryptionAtRestEnabled(encryptionatrest, "AWS") {
+		return fmt.Errorf("Encryption at rest is still enabled")
+	}
+
+	return nil
+}
+
+func testAccCheckMongoatlasEncryptionAtRestExists(n string, encryptionatrest *EncryptionAtRest) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No encryption at rest ID is set")
+		}
+		return nil
+	}
+}
+
+func TestAccMongoAtlasEncryptionAtRestEnabled(t *testing.T) {
+	enabled := new(bool)
+	*enabled = true
+
+	encryptionatrest := &EncryptionAtRest{
+		AwsKms:        &AwsKms{Enabled: enabled},
+		AzureKeyVault: &AzureKeyVault{Enabled: new(bool)},
+	}
+
+	cases := []struct {
+		Provider string
+		Enabled  bool
+	}{
+		{
+			Provider: "AWS",
+			Enabled:  true,
+		},
+		{
+			Provider: "AZURE",
+			Enabled:  false,
+		},
+		{
+			Provider: "GCP",
+			Enabled:  false,
+		},
+		{
+			Provider: "NONE",
+			Enabled:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		if encryptionAtRestEnabled(encryptionatrest, tc.Provider) != tc.Enabled {
+			t.Fatalf("Expected %s to be enabled: %t", tc.Provider, tc.Enabled)
+		}
+	}
+}
@@ -8,13 +8,16 @@ import (
 	"log"
 	"strings"
 	"io/ioutil"
+	"time"
 )
 
 type GroupipWhitelist struct {
-	CidrBlock string `json:"cidrBlock,omitempty"`
-	IpAddress string `json:"ipAddress,omitempty"`
-	GroupId   string `json:"groupId,omitempty"`
-	Comment   string `json:"comment,omitempty"`
+	CidrBlock        string `json:"cidrBlock,omitempty"`
+	IpAddress        string `json:"ipAddress,omitempty"`
+	AwsSecurityGroup string `json:"awsSecurityGroup,omitempty"`
+	GroupId          string `json:"groupId,omitempty"`
+	Comment          string `json:"comment"` // sent empty too, so a comment can be cleared
+	DeleteAfterDate  string `json:"deleteAfterDate,omitempty"`
 }
 
 type RequestObject struct {
@@ -23,52 +26,161 @@ type RequestObject struct {
 
 func resourceGroupipWhitelist() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceGroupipWhitelistCreate,
-		Update: resourceGroupipWhitelistUpdate,
-		Read:   resourceGroupipWhitelistRead,
-		Delete: resourceGroupipWhitelistDelete,
+		Create:        resourceGroupipWhitelistCreate,
+		Update:        resourceGroupipWhitelistUpdate,
+		Read:          resourceGroupipWhitelistRead,
+		Delete:        resourceGroupipWhitelistDelete,
+		CustomizeDiff: resourceGroupipWhitelistCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"cidrBlock": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  validateCidrBlock,
+				ConflictsWith: []string{"ipAddress", "awsSecurityGroup"},
 			},
 			"ipAddress": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"cidrBlock", "awsSecurityGroup"},
 			},
-			"groupId": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+			// only for projects peered with the VPC the security group belongs to
+			"awsSecurityGroup": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"cidrBlock", "ipAddress"},
 			},
+			"groupId": groupIdSchema(),
 			"comment": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			// Atlas removes the entry on its own once the date has passed
+			"deleteAfterDate": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentTime,
+			},
 		},
 	}
 }
 
+// checkWhitelistAddress makes sure an address is given, ConflictsWith already
+// keeps it to one kind. Missing addresses are only an error once known reports
+// all of them known, those still unknown at plan time are checked on apply.
+// ipAddress and cidrBlock are computed, so that includes every new entry.
+func checkWhitelistAddress(d interface {
+	GetOk(string) (interface{}, bool)
+}, known func(string) bool) error {
+	allKnown := true
+	for _, field := range []string{"ipAddress", "cidrBlock", "awsSecurityGroup"} {
+		if _, ok := d.GetOk(field); ok {
+			return nil
+		}
+		if !known(field) {
+			allKnown = false
+		}
+	}
+
+	if !allKnown {
+		return nil
+	}
+	return fmt.Errorf("One of ipAddress, cidrBlock or awsSecurityGroup must be set")
+}
+
+func resourceGroupipWhitelistCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	return checkWhitelistAddress(d, d.NewValueKnown)
+}
+
+// whitelistEntryAddress returns what Atlas identifies an entry by, security
+// group entries have no cidrBlock
+func whitelistEntryAddress(entry GroupipWhitelist) string {
+	if entry.AwsSecurityGroup != "" {
+		return entry.AwsSecurityGroup
+	}
+	return entry.CidrBlock
+}
+
+// whitelistEntryExpired reports whether Atlas already removed, or is about to
+// remove, a temporary entry
+func whitelistEntryExpired(entry GroupipWhitelist) bool {
+	if entry.DeleteAfterDate == "" {
+		return false
+	}
+
+	deleteAfterDate, err := time.Parse(time.RFC3339, entry.DeleteAfterDate)
+	if err != nil {
+		return false
+	}
+	return !deleteAfterDate.After(time.Now())
+}
+
 func resourceGroupipWhitelistCreate(d *schema.ResourceData, m interface{}) error {
 	// Instantiate the MongoatlasClient. Source in client.go, it handles all the HTTP REST API communication
-	var isip bool
 	client := m.(*MongoatlasClient)
 
+	groupipwhitelist, isip, err := newGroupipWhitelist(d)
+	if err != nil {
+		return err
+	}
+
+	if err := postGroupipWhitelist(client, d.Get("groupId").(string), groupipwhitelist); err != nil {
+		return err
+	}
+
+	if groupipwhitelist.AwsSecurityGroup != "" {
+		d.SetId(groupipwhitelist.AwsSecurityGroup)
+	} else if isip {
+		d.SetId(d.Get("ipAddress").(string) + "/32")
+		d.Set("cidrBlock", d.Get("ipAddress").(string)+"/32")
+		d.Set("ipAddress", d.Get("ipAddress").(string))
+	} else {
+		d.SetId(d.Get("cidrBlock").(string))
+		d.Set("cidrBlock", d.Get("cidrBlock").(string))
+	}
+
+	return resourceGroupipWhitelistRead(d, m)
+}
+
+// newGroupipWhitelist builds the entry from the configuration, isip tells a
+// single address from a block
+func newGroupipWhitelist(d *schema.ResourceData) (*GroupipWhitelist, bool, error) {
+	var isip bool
+
 	groupipwhitelist := &GroupipWhitelist{}
 
+	// ConflictsWith keeps it to one address kind, the values are only all known now
 	if attr, ok := d.GetOk("ipAddress"); ok {
 		groupipwhitelist.IpAddress = attr.(string)
 		isip = true
 	} else if attr, ok := d.GetOk("cidrBlock"); ok {
 		groupipwhitelist.CidrBlock = attr.(string)
 		isip = false
+	} else if attr, ok := d.GetOk("awsSecurityGroup"); ok {
+		groupipwhitelist.AwsSecurityGroup = attr.(string)
+	} else {
+		return nil, false, checkWhitelistAddress(d, func(string) bool { return true })
 	}
 
 	if attr, ok := d.GetOk("comment"); ok {
 		groupipwhitelist.Comment = attr.(string)
 	}
 
+	if attr, ok := d.GetOk("deleteAfterDate"); ok {
+		groupipwhitelist.DeleteAfterDate = attr.(string)
+	}
+
+	return groupipwhitelist, isip, nil
+}
+
+// postGroupipWhitelist creates an entry, posting an existing entry again
+// replaces its comment and deleteAfterDate
+func postGroupipWhitelist(client *MongoatlasClient, groupId string, groupipwhitelist *GroupipWhitelist) error {
 	requestobject := &RequestObject{
 		Requestarray: []GroupipWhitelist{*groupipwhitelist},
 	}
@@ -83,7 +195,7 @@ func resourceGroupipWhitelistCreate(d *schema.ResourceData, m interface{}) error
 
 	// communication with API commence here
 	groupipwhitelist_req, err := client.Post(fmt.Sprintf("groups/%s/whitelist",
-		d.Get("groupId").(string),
+		groupId,
 	), jsonpayload)
 	if err != nil {
 		return err
@@ -96,26 +208,17 @@ func resourceGroupipWhitelistCreate(d *schema.ResourceData, m interface{}) error
 		if err != nil {
 			return err
 		}
-		return fmt.Errorf("Failed to create vpc peering got status code %d", string(body))
-	}
-
-	if isip {
-		d.SetId(d.Get("ipAddress").(string) + "/32")
-		d.Set("cidrBlock", d.Get("ipAddress").(string)+"/32")
-		d.Set("ipAddress", d.Get("ipAddress").(string))
-	} else {
-		d.SetId(d.Get("cidrBlock").(string))
-		d.Set("cidrBlock", d.Get("cidrBlock").(string))
+		return fmt.Errorf("Failed to create whitelist entry. Got the following response body %s", string(body))
 	}
 
-	return resourceGroupipWhitelistRead(d, m)
+	return nil
 }
 
 func resourceGroupipWhitelistRead(d *schema.ResourceData, m interface{}) error {
 	client := m.(*MongoatlasClient)
 
 	log.Printf("%s", d.Get("groupId").(string))
-	address := strings.Replace(d.Get("cidrBlock").(string), "/", "%2F", -1)
+	address := strings.Replace(d.Id(), "/", "%2F", -1)
 
 	groupipwhitelist_req, err := client.Get(fmt.Sprintf("groups/%s/whitelist/%s",
 		d.Get("groupId").(string),
@@ -126,6 +229,12 @@ func resourceGroupipWhitelistRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if groupipwhitelist_req.StatusCode == 404 {
+		log.Printf("[DEBUG] whitelist entry %s no longer exist, so we'll drop it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
 	var groupipwhitelist GroupipWhitelist
 
 	decoder := json.NewDecoder(groupipwhitelist_req.Body)
@@ -136,21 +245,42 @@ func resourceGroupipWhitelistRead(d *schema.ResourceData, m interface{}) error {
 
 	log.Printf("Received %s \n", groupipwhitelist_req.Body)
 
+	// Atlas keeps returning a temporary entry for a little while after it expired
+	if whitelistEntryExpired(groupipwhitelist) {
+		log.Printf("[DEBUG] whitelist entry %s expired on %s, so we'll drop it from the state", d.Id(), groupipwhitelist.DeleteAfterDate)
+		d.SetId("")
+		return nil
+	}
+
 	d.Set("cidrBlock", groupipwhitelist.CidrBlock)
 	d.Set("ipAddress", groupipwhitelist.IpAddress)
-	d.Set("Comment", groupipwhitelist.Comment)
+	d.Set("awsSecurityGroup", groupipwhitelist.AwsSecurityGroup)
+	d.Set("comment", groupipwhitelist.Comment)
+	d.Set("deleteAfterDate", groupipwhitelist.DeleteAfterDate)
 
 	return nil
 }
 
 func resourceGroupipWhitelistUpdate(d *schema.ResourceData, m interface{}) error {
-	return nil
+	client := m.(*MongoatlasClient)
+
+	// address changes force a new entry, only comment and deleteAfterDate get here
+	groupipwhitelist, _, err := newGroupipWhitelist(d)
+	if err != nil {
+		return err
+	}
+
+	if err := postGroupipWhitelist(client, d.Get("groupId").(string), groupipwhitelist); err != nil {
+		return err
+	}
+
+	return resourceGroupipWhitelistRead(d, m)
 }
 
 func resourceGroupipWhitelistDelete(d *schema.ResourceData, m interface{}) error {
 	client := m.(*MongoatlasClient)
 
-	address := strings.Replace(d.Get("cidrBlock").(string), "/", "%2F", -1)
+	address := strings.Replace(d.Id(), "/", "%2F", -1)
 
 	delete_response, err := client.Delete(fmt.Sprintf("groups/%s/whitelist/%s",
 		d.Get("groupId").(string),
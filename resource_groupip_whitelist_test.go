@@ -5,8 +5,10 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -15,13 +17,15 @@ func TestAccMongoatlasGroupipWhitelist_basic(t *testing.T) {
 
 	testGroupId := os.Getenv("MONGOATLAS_GROUPID")
 
-	testAccMongoatlasGroupipWhitelistConfig := fmt.Sprintf(
-		`resource "mongoatlas_groupip_whitelist" "acceptancetest_groupipwhitelist" {
-			cidrBlock = "1.2.3.4/32"
-		    groupId = "%s"
-		    comment = "terraform test"
-		}
-	`, testGroupId)
+	testAccMongoatlasGroupipWhitelistConfig := func(comment string) string {
+		return fmt.Sprintf(
+			`resource "mongoatlas_groupip_whitelist" "acceptancetest_groupipwhitelist" {
+				cidrBlock = "1.2.3.4/32"
+			    groupId = "%s"
+			    comment = "%s"
+			}
+		`, testGroupId, comment)
+	}
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -29,9 +33,19 @@ func TestAccMongoatlasGroupipWhitelist_basic(t *testing.T) {
 		CheckDestroy: testAccCheckMongoatlasGroupipWhitelistDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccMongoatlasGroupipWhitelistConfig,
+				Config: testAccMongoatlasGroupipWhitelistConfig("terraform test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoatlasGroupipWhitelistExists("mongoatlas_groupip_whitelist.acceptancetest_groupipwhitelist", &groupipwhitelist),
+					resource.TestCheckResourceAttr(
+						"mongoatlas_groupip_whitelist.acceptancetest_groupipwhitelist", "comment", "terraform test"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccMongoatlasGroupipWhitelistConfig("terraform test updated"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckMongoatlasGroupipWhitelistExists("mongoatlas_groupip_whitelist.acceptancetest_groupipwhitelist", &groupipwhitelist),
+					resource.TestCheckResourceAttr(
+						"mongoatlas_groupip_whitelist.acceptancetest_groupipwhitelist", "comment", "terraform test updated"),
 				),
 			},
 		},
@@ -73,3 +87,77 @@ func testAccCheckMongoatlasGroupipWhitelistExists(n string, groupipwhitelist *Gr
 		return nil
 	}
 }
+
+func TestAccMongoAtlasGroupipWhitelistExpired(t *testing.T) {
+	cases := []struct {
+		DeleteAfterDate string
+		Expired         bool
+	}{
+		{
+			DeleteAfterDate: "",
+			Expired:         false,
+		},
+		{
+			DeleteAfterDate: time.Now().Add(-time.Hour).Format(time.RFC3339),
+			Expired:         true,
+		},
+		{
+			DeleteAfterDate: time.Now().Add(time.Hour).Format(time.RFC3339),
+			Expired:         false,
+		},
+		{
+			DeleteAfterDate: "not a date",
+			Expired:         false,
+		},
+	}
+
+	for _, tc := range cases {
+		entry := GroupipWhitelist{CidrBlock: "1.2.3.4/32", DeleteAfterDate: tc.DeleteAfterDate}
+		if whitelistEntryExpired(entry) != tc.Expired {
+			t.Fatalf("Expected expired to be %t for %s", tc.Expired, tc.DeleteAfterDate)
+		}
+	}
+}
+
+func TestAccMongoAtlasGroupipWhitelistAddress_validation(t *testing.T) {
+	cases := []struct {
+		Value    map[string]interface{}
+		Unknown  []string
+		ErrCount int
+	}{
+		{
+			Value:    map[string]interface{}{"ipAddress": "1.2.3.4"},
+			ErrCount: 0,
+		},
+		{
+			Value:    map[string]interface{}{"cidrBlock": "1.2.3.0/24"},
+			ErrCount: 0,
+		},
+		{
+			Value:    map[string]interface{}{"awsSecurityGroup": "sg-123456"},
+			ErrCount: 0,
+		},
+		{
+			Value:    map[string]interface{}{"comment": "no address"},
+			ErrCount: 1,
+		},
+		{
+			Value:    map[string]interface{}{"comment": "address from another resource"},
+			Unknown:  []string{"cidrBlock"},
+			ErrCount: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, resourceGroupipWhitelist().Schema, tc.Value)
+		known := func(field string) bool { return !stringInSlice(field, tc.Unknown) }
+
+		errCount := 0
+		if err := checkWhitelistAddress(d, known); err != nil {
+			errCount = 1
+		}
+		if errCount != tc.ErrCount {
+			t.Fatalf("Expected %+v Validation Error, Got %+v Validation Error for %+v VALUE", tc.ErrCount, errCount, tc.Value)
+		}
+	}
+}
@@ -0,0 +1,465 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"io/ioutil"
+	"log"
+	"strings"
+	"time"
+)
+
+type UserSecurity struct {
+	Ldap *Ldap `json:"ldap,omitempty"`
+}
+
+type Ldap struct {
+	AuthenticationEnabled *bool              `json:"authenticationEnabled,omitempty"`
+	AuthorizationEnabled  *bool              `json:"authorizationEnabled,omitempty"`
+	Hostname              string             `json:"hostname,omitempty"`
+	Port                  int                `json:"port,omitempty"`
+	BindUsername          string             `json:"bindUsername,omitempty"`
+	BindPassword          string             `json:"bindPassword,omitempty"`
+	CaCertificate         string             `json:"caCertificate,omitempty"`
+	AuthzQueryTemplate    string             `json:"authzQueryTemplate,omitempty"`
+	UserToDNMapping       *[]UserToDNMapping `json:"userToDNMapping,omitempty"`
+}
+
+type UserToDNMapping struct {
+	Match        string `json:"match,omitempty"`
+	Substitution string `json:"substitution,omitempty"`
+	LdapQuery    string `json:"ldapQuery,omitempty"`
+}
+
+type LdapVerify struct {
+	RequestId   string           `json:"requestId,omitempty"`
+	Status      string           `json:"status,omitempty"`
+	Request     *Ldap            `json:"request,omitempty"`
+	Validations []LdapValidation `json:"validations,omitempty"`
+}
+
+type LdapValidation struct {
+	Status         string `json:"status,omitempty"`
+	ValidationType string `json:"validationType,omitempty"`
+}
+
+// ldapConnectionSchema returns the arguments describing the LDAP server, shared
+// by mongoatlas_ldap_configuration and mongoatlas_ldap_verify
+func ldapConnectionSchema(forceNew bool) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"groupId": groupIdSchema(),
+		"hostname": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: forceNew,
+		},
+		"port": &schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+			ForceNew: forceNew,
+			Default:  636,
+		},
+		"bindUsername": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: forceNew,
+		},
+		// Atlas never returns it, the value from the configuration is kept
+		"bindPassword": &schema.Schema{
+			Type:      schema.TypeString,
+			Required:  true,
+			ForceNew:  forceNew,
+			Sensitive: true,
+		},
+		"caCertificate": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: forceNew,
+		},
+		"authzQueryTemplate": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: forceNew,
+		},
+	}
+}
+
+func resourceLdapConfiguration() *schema.Resource {
+	ldapSchema := ldapConnectionSchema(false)
+	ldapSchema["authenticationEnabled"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  true,
+	}
+	ldapSchema["authorizationEnabled"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+	ldapSchema["userToDNMapping"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"match": &schema.Schema{
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"substitution": &schema.Schema{
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"ldapQuery": &schema.Schema{
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Create: resourceLdapConfigurationCreate,
+		Update: resourceLdapConfigurationUpdate,
+		Read:   resourceLdapConfigurationRead,
+		Delete: resourceLdapConfigurationDelete,
+		Schema: ldapSchema,
+	}
+}
+
+func resourceLdapVerify() *schema.Resource {
+	verifySchema := ldapConnectionSchema(true)
+	verifySchema["requestId"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	verifySchema["status"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	verifySchema["validations"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"status": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"validationType": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Create: resourceLdapVerifyCreate,
+		Read:   resourceLdapVerifyRead,
+		Delete: resourceLdapVerifyDelete,
+		Schema: verifySchema,
+	}
+}
+
+func newLdap(d *schema.ResourceData) *Ldap {
+	return &Ldap{
+		Hostname:           d.Get("hostname").(string),
+		Port:               d.Get("port").(int),
+		BindUsername:       d.Get("bindUsername").(string),
+		BindPassword:       d.Get("bindPassword").(string),
+		CaCertificate:      d.Get("caCertificate").(string),
+		AuthzQueryTemplate: d.Get("authzQueryTemplate").(string),
+	}
+}
+
+func patchUserSecurity(client *MongoatlasClient, groupId string, usersecurity *UserSecurity) error {
+	var jsonbuffer []byte
+
+	jsonpayload := bytes.NewBuffer(jsonbuffer)
+	enc := json.NewEncoder(jsonpayload)
+	enc.Encode(usersecurity)
+
+	// the payload carries the bind password, so it is not logged
+	log.Printf("Configuring LDAP for %s \n", groupId)
+
+	usersecurity_req, err := client.Patch(fmt.Sprintf("groups/%s/userSecurity", groupId), jsonpayload)
+	if err != nil {
+		return err
+	}
+
+	if usersecurity_req.StatusCode != 200 {
+		body, err := ioutil.ReadAll(usersecurity_req.Body)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("Failed to configure LDAP. Got the following response body %s", string(body))
+	}
+	return nil
+}
+
+func putLdapConfiguration(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	authenticationEnabled := d.Get("authenticationEnabled").(bool)
+	authorizationEnabled := d.Get("authorizationEnabled").(bool)
+
+	ldap := newLdap(d)
+	ldap.AuthenticationEnabled = &authenticationEnabled
+	ldap.AuthorizationEnabled = &authorizationEnabled
+
+	mappings := []UserToDNMapping{}
+	for _, raw := range d.Get("userToDNMapping").([]interface{}) {
+		mapping := raw.(map[string]interface{})
+		mappings = append(mappings, UserToDNMapping{
+			Match:        mapping["match"].(string),
+			Substitution: mapping["substitution"].(string),
+			LdapQuery:    mapping["ldapQuery"].(string),
+		})
+	}
+	ldap.UserToDNMapping = &mappings
+
+	return patchUserSecurity(client, d.Get("groupId").(string), &UserSecurity{Ldap: ldap})
+}
+
+func resourceLdapConfigurationCreate(d *schema.ResourceData, m interface{}) error {
+	if err := putLdapConfiguration(d, m); err != nil {
+		return err
+	}
+
+	d.SetId(d.Get("groupId").(string))
+
+	return resourceLdapConfigurationRead(d, m)
+}
+
+func getUserSecurity(client *MongoatlasClient, groupId string) (*UserSecurity, error) {
+	usersecurity_req, err := client.Get(fmt.Sprintf("groups/%s/userSecurity", groupId))
+	if err != nil {
+		return nil, err
+	}
+
+	if usersecurity_req.StatusCode != 200 {
+		body, err := ioutil.ReadAll(usersecurity_req.Body)
+		if err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("Failed to read LDAP configuration. Got the following response body %s", string(body))
+	}
+
+	var usersecurity UserSecurity
+
+	decoder := json.NewDecoder(usersecurity_req.Body)
+	err = decoder.Decode(&usersecurity)
+	if err != nil {
+		return nil, err
+	}
+	return &usersecurity, nil
+}
+
+func resourceLdapConfigurationRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	usersecurity, err := getUserSecurity(client, d.Get("groupId").(string))
+	if err != nil {
+		return err
+	}
+
+	if usersecurity.Ldap == nil || usersecurity.Ldap.Hostname == "" {
+		log.Printf("[DEBUG] LDAP is no longer configured for %s, so we'll drop it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	ldap := usersecurity.Ldap
+
+	d.Set("hostname", ldap.Hostname)
+	d.Set("port", ldap.Port)
+	d.Set("bindUsername", ldap.BindUsername)
+	d.Set("caCertificate", ldap.CaCertificate)
+	d.Set("authzQueryTemplate", ldap.AuthzQueryTemplate)
+	if ldap.AuthenticationEnabled != nil {
+		d.Set("authenticationEnabled", *ldap.AuthenticationEnabled)
+	}
+	if ldap.AuthorizationEnabled != nil {
+		d.Set("authorizationEnabled", *ldap.AuthorizationEnabled)
+	}
+
+	var s []map[string]interface{}
+	if ldap.UserToDNMapping != nil {
+		for _, t := range *ldap.UserToDNMapping {
+			s = append(s, map[string]interface{}{
+				"match":        t.Match,
+				"substitution": t.Substitution,
+				"ldapQuery":    t.LdapQuery,
+			})
+		}
+	}
+	if err := d.Set("userToDNMapping", s); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceLdapConfigurationUpdate(d *schema.ResourceData, m interface{}) error {
+	if err := putLdapConfiguration(d, m); err != nil {
+		return err
+	}
+
+	return resourceLdapConfigurationRead(d, m)
+}
+
+func resourceLdapConfigurationDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	// Atlas keeps the LDAP settings around, turning LDAP off is as far as it goes
+	disabled := false
+	return patchUserSecurity(client, d.Get("groupId").(string), &UserSecurity{
+		Ldap: &Ldap{
+			AuthenticationEnabled: &disabled,
+			AuthorizationEnabled:  &disabled,
+		},
+	})
+}
+
+func getLdapVerify(client *MongoatlasClient, groupId string, requestId string) (*LdapVerify, error) {
+	verify_req, err := client.Get(fmt.Sprintf("groups/%s/userSecurity/ldap/verify/%s", groupId, requestId))
+	if err != nil {
+		return nil, err
+	}
+
+	if verify_req.StatusCode == 404 {
+		return nil, nil
+	}
+
+	if verify_req.StatusCode != 200 {
+		body, err := ioutil.ReadAll(verify_req.Body)
+		if err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("Failed to read LDAP verification %s. Got the following response body %s", requestId, string(body))
+	}
+
+	var verify LdapVerify
+
+	decoder := json.NewDecoder(verify_req.Body)
+	err = decoder.Decode(&verify)
+	if err != nil {
+		return nil, err
+	}
+	return &verify, nil
+}
+
+// ldapVerifyFailures lists the validations Atlas did not pass
+func ldapVerifyFailures(verify *LdapVerify) []string {
+	failures := []string{}
+	for _, validation := range verify.Validations {
+		if validation.Status != "OK" {
+			failures = append(failures, fmt.Sprintf("%s: %s", validation.ValidationType, validation.Status))
+		}
+	}
+	return failures
+}
+
+func setLdapVerify(d *schema.ResourceData, verify *LdapVerify) error {
+	d.Set("requestId", verify.RequestId)
+	d.Set("status", verify.Status)
+
+	var s []map[string]interface{}
+	for _, t := range verify.Validations {
+		s = append(s, map[string]interface{}{
+			"status":         t.Status,
+			"validationType": t.ValidationType,
+		})
+	}
+	return d.Set("validations", s)
+}
+
+func resourceLdapVerifyCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	groupId := d.Get("groupId").(string)
+
+	var jsonbuffer []byte
+
+	jsonpayload := bytes.NewBuffer(jsonbuffer)
+	enc := json.NewEncoder(jsonpayload)
+	enc.Encode(newLdap(d))
+
+	// the payload carries the bind password, so it is not logged
+	log.Printf("Verifying LDAP configuration for %s \n", groupId)
+
+	verify_req, err := client.Post(fmt.Sprintf("groups/%s/userSecurity/ldap/verify", groupId), jsonpayload)
+	if err != nil {
+		return err
+	}
+
+	if verify_req.StatusCode != 200 && verify_req.StatusCode != 202 {
+		body, err := ioutil.ReadAll(verify_req.Body)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("Failed to verify LDAP configuration. Got the following response body %s", string(body))
+	}
+
+	var verify LdapVerify
+
+	decoder := json.NewDecoder(verify_req.Body)
+	err = decoder.Decode(&verify)
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"SUCCESS", "FAILED"},
+		Refresh: func() (interface{}, string, error) {
+			result, err := getLdapVerify(client, groupId, verify.RequestId)
+			if err != nil {
+				return nil, "", err
+			}
+			if result == nil {
+				return nil, "", fmt.Errorf("LDAP verification %s not found", verify.RequestId)
+			}
+			return result, result.Status, nil
+		},
+		Timeout:    5 * time.Minute,
+		MinTimeout: 5 * time.Second,
+	}
+	raw, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for LDAP verification %s: %s", verify.RequestId, err)
+	}
+
+	result := raw.(*LdapVerify)
+	if result.Status == "FAILED" {
+		return fmt.Errorf("LDAP verification failed: %s", strings.Join(ldapVerifyFailures(result), ", "))
+	}
+
+	d.SetId(result.RequestId)
+
+	return setLdapVerify(d, result)
+}
+
+func resourceLdapVerifyRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	verify, err := getLdapVerify(client, d.Get("groupId").(string), d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Atlas forgets old verifications, the outcome in the state still holds
+	if verify == nil {
+		log.Printf("[DEBUG] LDAP verification %s is no longer kept by Atlas", d.Id())
+		return nil
+	}
+
+	return setLdapVerify(d, verify)
+}
+
+func resourceLdapVerifyDelete(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccMongoatlasLdapConfiguration_basic(t *testing.T) {
+	testGroupId := os.Getenv("MONGOATLAS_GROUPID")
+	testLdapHostname := os.Getenv("MONGOATLAS_LDAP_HOSTNAME")
+	testLdapBindUsername := os.Getenv("MONGOATLAS_LDAP_BIND_USERNAME")
+	testLdapBindPassword := os.Getenv("MONGOATLAS_LDAP_BIND_PASSWORD")
+
+	testAccMongoatlasLdapConfigurationConfig := fmt.Sprintf(
+		`resource "mongoatlas_ldap_verify" "acceptancetest_ldapverify" {
+			hostname = "%s"
+			bindUsername = "%s"
+			bindPassword = "%s"
+			groupId = "%s"
+		}
+
+		resource "mongoatlas_ldap_configuration" "acceptancetest_ldapconfiguration" {
+			hostname = "${mongoatlas_ldap_verify.acceptancetest_ldapverify.hostname}"
+			bindUsername = "${mongoatlas_ldap_verify.acceptancetest_ldapverify.bindUsername}"
+			bindPassword = "%s"
+			userToDNMapping = [
+				{
+					match = "(.+)"
+					substitution = "CN={0},CN=Users,DC=acctest,DC=local"
+				}
+			]
+			groupId = "%s"
+		}
+	`, testLdapHostname, testLdapBindUsername, testLdapBindPassword, testGroupId, testLdapBindPassword, testGroupId)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if testLdapHostname == "" {
+				t.Skip("MONGOATLAS_LDAP_HOSTNAME must be set to test LDAP")
+			}
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoatlasLdapConfigurationDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccMongoatlasLdapConfigurationConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"mongoatlas_ldap_verify.acceptancetest_ldapverify", "status", "SUCCESS"),
+					resource.TestCheckResourceAttr(
+						"mongoatlas_ldap_configuration.acceptancetest_ldapconfiguration", "authenticationEnabled", "true"),
+					resource.TestCheckResourceAttr(
+						"mongoatlas_ldap_configuration.acceptancetest_ldapconfiguration", "userToDNMapping.0.match", "(.+)"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMongoatlasLdapConfigurationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*MongoatlasClient)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mongoatlas_ldap_configuration" {
+			continue
+		}
+
+		usersecurity, err := getUserSecurity(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if usersecurity.Ldap != nil && usersecurity.Ldap.AuthenticationEnabled != nil && *usersecurity.Ldap.AuthenticationEnabled {
+			return fmt.Errorf("LDAP authentication still enabled")
+		}
+	}
+
+	return nil
+}
+
+func TestAccMongoAtlasLdapVerifyFailures(t *testing.T) {
+	verify := &LdapVerify{
+		Status: "FAILED",
+		Validations: []LdapValidation{
+			{Status: "OK", ValidationType: "CONNECT"},
+			{Status: "FAIL", ValidationType: "AUTHENTICATE"},
+		},
+	}
+
+	failures := ldapVerifyFailures(verify)
+	if len(failures) != 1 || failures[0] != "AUTHENTICATE: FAIL" {
+		t.Fatalf("Expected [AUTHENTICATE: FAIL], Got %+v", failures)
+	}
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"io/ioutil"
+	"log"
+)
+
+type MaintenanceWindow struct {
+	DayOfWeek         int   `json:"dayOfWeek,omitempty"`
+	HourOfDay         *int  `json:"hourOfDay,omitempty"`
+	StartASAP         *bool `json:"startASAP,omitempty"`
+	NumberOfDeferrals int   `json:"numberOfDeferrals,omitempty"`
+}
+
+func resourceMaintenanceWindow() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMaintenanceWindowCreate,
+		Update: resourceMaintenanceWindowUpdate,
+		Read:   resourceMaintenanceWindowRead,
+		Delete: resourceMaintenanceWindowDelete,
+		Schema: map[string]*schema.Schema{
+			"groupId": groupIdSchema(),
+			"dayOfWeek": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateDayOfWeek,
+			},
+			"hourOfDay": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateHourOfDay,
+			},
+			// startASAP and defer are actions rather than settings, Atlas does
+			// not report them back so they are sent on every create or update
+			// planned while they are true
+			"startASAP": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"defer": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"numberOfDeferrals": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMaintenanceWindowCreate(d *schema.ResourceData, m interface{}) error {
+	d.SetId(d.Get("groupId").(string))
+
+	return resourceMaintenanceWindowUpdate(d, m)
+}
+
+func resourceMaintenanceWindowRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	maintenancewindow_req, err := client.Get(fmt.Sprintf("groups/%s/maintenanceWindow",
+		d.Get("groupId").(string),
+	))
+	if err != nil {
+		return err
+	}
+
+	if maintenancewindow_req.StatusCode != 200 {
+		body, err := ioutil.ReadAll(maintenancewindow_req.Body)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("Failed to read maintenance window. Got the following response body %s", string(body))
+	}
+
+	var maintenancewindow MaintenanceWindow
+
+	decoder := json.NewDecoder(maintenancewindow_req.Body)
+	err = decoder.Decode(&maintenancewindow)
+	if err != nil {
+		return err
+	}
+	log.Printf("Received %+v \n", maintenancewindow)
+
+	d.Set("dayOfWeek", maintenancewindow.DayOfWeek)
+	if maintenancewindow.HourOfDay != nil {
+		d.Set("hourOfDay", *maintenancewindow.HourOfDay)
+	}
+	d.Set("numberOfDeferrals", maintenancewindow.NumberOfDeferrals)
+
+	return nil
+}
+
+func resourceMaintenanceWindowUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	maintenancewindow := MaintenanceWindow{}
+
+	if attr, ok := d.GetOk("dayOfWeek"); ok {
+		maintenancewindow.DayOfWeek = attr.(int)
+	}
+
+	if attr, ok := d.GetOkExists("hourOfDay"); ok {
+		hourOfDay := new(int)
+		*hourOfDay = attr.(int)
+		maintenancewindow.HourOfDay = hourOfDay
+	}
+
+	if d.Get("startASAP").(bool) {
+		startASAP := new(bool)
+		*startASAP = true
+		maintenancewindow.StartASAP = startASAP
+	}
+
+	var jsonbuffer []byte
+
+	jsonpayload := bytes.NewBuffer(jsonbuffer)
+	enc := json.NewEncoder(jsonpayload)
+	enc.Encode(maintenancewindow)
+
+	log.Printf("Sending %s \n", jsonpayload)
+
+	maintenancewindow_req, err := client.Patch(fmt.Sprintf("groups/%s/maintenanceWindow",
+		d.Get("groupId").(string),
+	), jsonpayload)
+	if err != nil {
+		return err
+	}
+
+	if maintenancewindow_req.StatusCode != 200 {
+		body, err := ioutil.ReadAll(maintenancewindow_req.Body)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("Failed to patch maintenance window: %s", string(body))
+	}
+
+	if d.Get("defer").(bool) {
+		defer_req, err := client.Post(fmt.Sprintf("groups/%s/maintenanceWindow/defer",
+			d.Get("groupId").(string),
+		), bytes.NewBuffer(nil))
+		if err != nil {
+			return err
+		}
+
+		if defer_req.StatusCode != 200 {
+			body, err := ioutil.ReadAll(defer_req.Body)
+			if err != nil {
+				return err
+			}
+			return fmt.Errorf("Failed to defer maintenance window: %s", string(body))
+		}
+	}
+
+	return resourceMaintenanceWindowRead(d, m)
+}
+
+func resourceMaintenanceWindowDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	// deleting the maintenance window resets the project to the Atlas default
+	delete_response, err := client.Delete(fmt.Sprintf("groups/%s/maintenanceWindow",
+		d.Get("groupId").(string),
+	))
+	if err != nil {
+		return err
+	}
+
+	if delete_response.StatusCode != 200 && delete_response.StatusCode != 204 {
+		return fmt.Errorf("Failed to reset the maintenance window. Got status code %d", delete_response.StatusCode)
+	}
+	return nil
+}
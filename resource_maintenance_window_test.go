@@ -0,0 +1,158 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccMongoatlasMaintenanceWindow_basic(t *testing.T) {
+	var maintenancewindow MaintenanceWindow
+
+	testGroupId := os.Getenv("MONGOATLAS_GROUPID")
+
+	testAccMongoatlasMaintenanceWindowConfig := fmt.Sprintf(
+		`resource "mongoatlas_maintenance_window" "acceptancetest_maintenancewindow" {
+			groupId = "%s"
+			dayOfWeek = 7
+			hourOfDay = 0
+		}
+	`, testGroupId)
+
+	testAccMongoatlasMaintenanceWindowConfig_update := fmt.Sprintf(
+		`resource "mongoatlas_maintenance_window" "acceptancetest_maintenancewindow" {
+			groupId = "%s"
+			dayOfWeek = 1
+			hourOfDay = 3
+		}
+	`, testGroupId)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoatlasMaintenanceWindowDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccMongoatlasMaintenanceWindowConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoatlasMaintenanceWindowExists("mongoatlas_maintenance_window.acceptancetest_maintenancewindow", &maintenancewindow),
+					resource.TestCheckResourceAttr(
+						"mongoatlas_maintenance_window.acceptancetest_maintenancewindow", "hourOfDay", "0"),
+				),
+			},
+
+			resource.TestStep{
+				Config: testAccMongoatlasMaintenanceWindowConfig_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoatlasMaintenanceWindowExists("mongoatlas_maintenance_window.acceptancetest_maintenancewindow", &maintenancewindow),
+					resource.TestCheckResourceAttr(
+						"mongoatlas_maintenance_window.acceptancetest_maintenancewindow", "dayOfWeek", "1"),
+					resource.TestCheckResourceAttr(
+						"mongoatlas_maintenance_window.acceptancetest_maintenancewindow", "hourOfDay", "3"),
+				),
+			},
+		},
+	})
+
+}
+
+// the maintenance window cannot disappear, destroying it only resets it
+func testAccCheckMongoatlasMaintenanceWindowDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*MongoatlasClient)
+	rs, ok := s.RootModule().Resources["mongoatlas_maintenance_window.acceptancetest_maintenancewindow"]
+	if !ok {
+		return fmt.Errorf("Not found %s", "mongoatlas_maintenance_window.acceptancetest_maintenancewindow")
+	}
+
+	response, err := client.Get(fmt.Sprintf("groups/%s/maintenanceWindow", rs.Primary.Attributes["groupId"]))
+
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode != 200 {
+		return fmt.Errorf("Failed to read the maintenance window. Got status code %d", response.StatusCode)
+	}
+
+	return nil
+}
+
+func testAccCheckMongoatlasMaintenanceWindowExists(n string, maintenancewindow *MaintenanceWindow) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No maintenance window ID is set")
+		}
+		return nil
+	}
+}
+
+func TestAccMongoAtlasMaintenanceWindowDayOfWeek_validation(t *testing.T) {
+	cases := []struct {
+		Value    int
+		ErrCount int
+	}{
+		{
+			Value:    0,
+			ErrCount: 1,
+		},
+		{
+			Value:    1,
+			ErrCount: 0,
+		},
+		{
+			Value:    7,
+			ErrCount: 0,
+		},
+		{
+			Value:    8,
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateDayOfWeek(tc.Value, "mongoatlas_maintenance_window_dayofweek")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %+v Validation Error, Got %+v Validation Error for %+v VALUE", tc.ErrCount, len(errors), tc.Value)
+		}
+	}
+}
+
+func TestAccMongoAtlasMaintenanceWindowHourOfDay_validation(t *testing.T) {
+	cases := []struct {
+		Value    int
+		ErrCount int
+	}{
+		{
+			Value:    -1,
+			ErrCount: 1,
+		},
+		{
+			Value:    0,
+			ErrCount: 0,
+		},
+		{
+			Value:    23,
+			ErrCount: 0,
+		},
+		{
+			Value:    24,
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateHourOfDay(tc.Value, "mongoatlas_maintenance_window_hourofday")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %+v Validation Error, Got %+v Validation Error for %+v VALUE", tc.ErrCount, len(errors), tc.Value)
+		}
+	}
+}
@@ -0,0 +1,417 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"io/ioutil"
+	"log"
+	"strings"
+	"time"
+)
+
+type PrivateEndpoint struct {
+	Id                  string   `json:"id,omitempty"`
+	ProviderName        string   `json:"providerName,omitempty"`
+	Region              string   `json:"region,omitempty"`
+	EndpointServiceName string   `json:"endpointServiceName,omitempty"`
+	ErrorMessage        string   `json:"errorMessage,omitempty"`
+	InterfaceEndpoints  []string `json:"interfaceEndpoints,omitempty"`
+	Status              string   `json:"status,omitempty"`
+}
+
+type InterfaceEndpoint struct {
+	InterfaceEndpointId string `json:"interfaceEndpointId,omitempty"`
+	ConnectionStatus    string `json:"connectionStatus,omitempty"`
+	DeleteRequested     bool   `json:"deleteRequested,omitempty"`
+	ErrorMessage        string `json:"errorMessage,omitempty"`
+}
+
+func resourcePrivateEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePrivateEndpointCreate,
+		Read:   resourcePrivateEndpointRead,
+		Delete: resourcePrivateEndpointDelete,
+		Schema: map[string]*schema.Schema{
+			"groupId": groupIdSchema(),
+			"providerName": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "AWS",
+				ValidateFunc: validateProviderName,
+			},
+			// AWS region name, e.g. us-east-1, not the Atlas US_EAST_1 format
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"endpointServiceName": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"interfaceEndpoints": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePrivateEndpointInterfaceLink() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePrivateEndpointInterfaceLinkCreate,
+		Read:   resourcePrivateEndpointInterfaceLinkRead,
+		Delete: resourcePrivateEndpointInterfaceLinkDelete,
+		Schema: map[string]*schema.Schema{
+			"groupId": groupIdSchema(),
+			"privateEndpointId": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"interfaceEndpointId": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"connectionStatus": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// getPrivateEndpoint returns nil when the endpoint service does not exist
+func getPrivateEndpoint(client *MongoatlasClient, groupId string, id string) (*PrivateEndpoint, error) {
+	privateendpoint_req, err := client.Get(fmt.Sprintf("groups/%s/privateEndpoint/%s", groupId, id))
+	if err != nil {
+		return nil, err
+	}
+
+	if privateendpoint_req.StatusCode == 404 {
+		return nil, nil
+	}
+
+	if privateendpoint_req.StatusCode != 200 {
+		body, err := ioutil.ReadAll(privateendpoint_req.Body)
+		if err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("Failed to read private endpoint %s. Got the following response body %s", id, string(body))
+	}
+
+	var privateendpoint PrivateEndpoint
+
+	decoder := json.NewDecoder(privateendpoint_req.Body)
+	err = decoder.Decode(&privateendpoint)
+	if err != nil {
+		return nil, err
+	}
+	return &privateendpoint, nil
+}
+
+// getInterfaceEndpoint returns nil when the interface endpoint is not linked
+func getInterfaceEndpoint(client *MongoatlasClient, groupId string, privateEndpointId string, id string) (*InterfaceEndpoint, error) {
+	interfaceendpoint_req, err := client.Get(fmt.Sprintf("groups/%s/privateEndpoint/%s/interfaceEndpoints/%s", groupId, privateEndpointId, id))
+	if err != nil {
+		return nil, err
+	}
+
+	if interfaceendpoint_req.StatusCode == 404 {
+		return nil, nil
+	}
+
+	if interfaceendpoint_req.StatusCode != 200 {
+		body, err := ioutil.ReadAll(interfaceendpoint_req.Body)
+		if err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("Failed to read interface endpoint %s. Got the following response body %s", id, string(body))
+	}
+
+	var interfaceendpoint InterfaceEndpoint
+
+	decoder := json.NewDecoder(interfaceendpoint_req.Body)
+	err = decoder.Decode(&interfaceendpoint)
+	if err != nil {
+		return nil, err
+	}
+	return &interfaceendpoint, nil
+}
+
+func privateEndpointRefreshFunc(client *MongoatlasClient, groupId string, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		privateendpoint, err := getPrivateEndpoint(client, groupId, id)
+		if err != nil {
+			return nil, "", err
+		}
+		if privateendpoint == nil {
+			return "", "DELETED", nil
+		}
+		if privateendpoint.Status == "FAILED" {
+			return nil, "", fmt.Errorf("Private endpoint %s failed: %s", id, privateendpoint.ErrorMessage)
+		}
+		return privateendpoint, privateendpoint.Status, nil
+	}
+}
+
+func interfaceEndpointRefreshFunc(client *MongoatlasClient, groupId string, privateEndpointId string, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		interfaceendpoint, err := getInterfaceEndpoint(client, groupId, privateEndpointId, id)
+		if err != nil {
+			return nil, "", err
+		}
+		if interfaceendpoint == nil {
+			return "", "DELETED", nil
+		}
+		if interfaceendpoint.ConnectionStatus == "REJECTED" {
+			return nil, "", fmt.Errorf("Interface endpoint %s was rejected: %s", id, interfaceendpoint.ErrorMessage)
+		}
+		return interfaceendpoint, interfaceendpoint.ConnectionStatus, nil
+	}
+}
+
+func resourcePrivateEndpointCreate(d *schema.ResourceData, m interface{}) error {
+	// Instantiate the MongoatlasClient. Source in client.go, it handles all the HTTP REST API communication
+	client := m.(*MongoatlasClient)
+
+	groupId := d.Get("groupId").(string)
+
+	privateendpoint := &PrivateEndpoint{
+		ProviderName: d.Get("providerName").(string),
+		Region:       d.Get("region").(string),
+	}
+
+	var jsonbuffer []byte
+
+	jsonpayload := bytes.NewBuffer(jsonbuffer)
+	enc := json.NewEncoder(jsonpayload)
+	enc.Encode(privateendpoint)
+
+	log.Printf("Sending %s \n", jsonpayload)
+
+	// communication with API commence here
+	privateendpoint_req, err := client.Post(fmt.Sprintf("groups/%s/privateEndpoint", groupId), jsonpayload)
+	if err != nil {
+		return err
+	}
+
+	if privateendpoint_req.StatusCode != 200 && privateendpoint_req.StatusCode != 201 {
+		body, err := ioutil.ReadAll(privateendpoint_req.Body)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("Failed to create private endpoint. Got the following response body %s", string(body))
+	}
+
+	decoder := json.NewDecoder(privateendpoint_req.Body)
+	err = decoder.Decode(&privateendpoint)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(privateendpoint.Id)
+
+	// the endpoint service name is only known once Atlas waits for the
+	// interface endpoint, AVAILABLE means one is already linked
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"INITIATING"},
+		Target:     []string{"WAITING_FOR_USER", "AVAILABLE"},
+		Refresh:    privateEndpointRefreshFunc(client, groupId, d.Id()),
+		Timeout:    20 * time.Minute,
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for private endpoint %s to become available: %s", d.Id(), err)
+	}
+
+	return resourcePrivateEndpointRead(d, m)
+}
+
+func resourcePrivateEndpointRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	privateendpoint, err := getPrivateEndpoint(client, d.Get("groupId").(string), d.Id())
+	if err != nil {
+		return err
+	}
+
+	if privateendpoint == nil || privateendpoint.Status == "DELETING" {
+		log.Printf("[DEBUG] private endpoint %s no longer exist, so we'll drop it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("providerName", privateendpoint.ProviderName)
+	d.Set("region", strings.ToLower(strings.Replace(privateendpoint.Region, "_", "-", -1)))
+	d.Set("endpointServiceName", privateendpoint.EndpointServiceName)
+	d.Set("interfaceEndpoints", privateendpoint.InterfaceEndpoints)
+	d.Set("status", privateendpoint.Status)
+
+	return nil
+}
+
+func resourcePrivateEndpointDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	groupId := d.Get("groupId").(string)
+
+	delete_response, err := client.Delete(fmt.Sprintf("groups/%s/privateEndpoint/%s", groupId, d.Id()))
+	if err != nil {
+		return err
+	}
+
+	if delete_response.StatusCode == 404 {
+		return nil
+	}
+
+	if delete_response.StatusCode != 200 && delete_response.StatusCode != 202 && delete_response.StatusCode != 204 {
+		body, err := ioutil.ReadAll(delete_response.Body)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("Failed to delete private endpoint %s. Got the following response body %s", d.Id(), string(body))
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"INITIATING", "WAITING_FOR_USER", "AVAILABLE", "DELETING"},
+		Target:     []string{"DELETED"},
+		Refresh:    privateEndpointRefreshFunc(client, groupId, d.Id()),
+		Timeout:    20 * time.Minute,
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for private endpoint %s to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourcePrivateEndpointInterfaceLinkCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	groupId := d.Get("groupId").(string)
+	privateEndpointId := d.Get("privateEndpointId").(string)
+
+	interfaceendpoint := &InterfaceEndpoint{
+		InterfaceEndpointId: d.Get("interfaceEndpointId").(string),
+	}
+
+	var jsonbuffer []byte
+
+	jsonpayload := bytes.NewBuffer(jsonbuffer)
+	enc := json.NewEncoder(jsonpayload)
+	enc.Encode(interfaceendpoint)
+
+	log.Printf("Sending %s \n", jsonpayload)
+
+	interfaceendpoint_req, err := client.Post(fmt.Sprintf("groups/%s/privateEndpoint/%s/interfaceEndpoints",
+		groupId,
+		privateEndpointId,
+	), jsonpayload)
+	if err != nil {
+		return err
+	}
+
+	if interfaceendpoint_req.StatusCode != 200 && interfaceendpoint_req.StatusCode != 201 {
+		body, err := ioutil.ReadAll(interfaceendpoint_req.Body)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("Failed to link interface endpoint. Got the following response body %s", string(body))
+	}
+
+	d.SetId(fmt.Sprintf("%s-%s", privateEndpointId, interfaceendpoint.InterfaceEndpointId))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"NONE", "PENDING_ACCEPTANCE", "PENDING"},
+		Target:     []string{"AVAILABLE"},
+		Refresh:    interfaceEndpointRefreshFunc(client, groupId, privateEndpointId, interfaceendpoint.InterfaceEndpointId),
+		Timeout:    20 * time.Minute,
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for interface endpoint %s to become available: %s", interfaceendpoint.InterfaceEndpointId, err)
+	}
+
+	return resourcePrivateEndpointInterfaceLinkRead(d, m)
+}
+
+func resourcePrivateEndpointInterfaceLinkRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	interfaceendpoint, err := getInterfaceEndpoint(client,
+		d.Get("groupId").(string),
+		d.Get("privateEndpointId").(string),
+		d.Get("interfaceEndpointId").(string),
+	)
+	if err != nil {
+		return err
+	}
+
+	if interfaceendpoint == nil || interfaceendpoint.DeleteRequested {
+		log.Printf("[DEBUG] interface endpoint %s no longer exist, so we'll drop it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("connectionStatus", interfaceendpoint.ConnectionStatus)
+
+	return nil
+}
+
+func resourcePrivateEndpointInterfaceLinkDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	groupId := d.Get("groupId").(string)
+	privateEndpointId := d.Get("privateEndpointId").(string)
+	interfaceEndpointId := d.Get("interfaceEndpointId").(string)
+
+	delete_response, err := client.Delete(fmt.Sprintf("groups/%s/privateEndpoint/%s/interfaceEndpoints/%s",
+		groupId,
+		privateEndpointId,
+		interfaceEndpointId,
+	))
+	if err != nil {
+		return err
+	}
+
+	if delete_response.StatusCode == 404 {
+		return nil
+	}
+
+	if delete_response.StatusCode != 200 && delete_response.StatusCode != 202 && delete_response.StatusCode != 204 {
+		body, err := ioutil.ReadAll(delete_response.Body)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("Failed to unlink interface endpoint %s. Got the following response body %s", interfaceEndpointId, string(body))
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"NONE", "PENDING_ACCEPTANCE", "PENDING", "AVAILABLE", "DELETING"},
+		Target:     []string{"DELETED"},
+		Refresh:    interfaceEndpointRefreshFunc(client, groupId, privateEndpointId, interfaceEndpointId),
+		Timeout:    20 * time.Minute,
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for interface endpoint %s to be unlinked: %s", interfaceEndpointId, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccMongoatlasPrivateEndpoint_basic(t *testing.T) {
+	var privateendpoint PrivateEndpoint
+
+	testGroupId := os.Getenv("MONGOATLAS_GROUPID")
+
+	testAccMongoatlasPrivateEndpointConfig := fmt.Sprintf(
+		`resource "mongoatlas_private_endpoint" "acceptancetest_endpoint" {
+			groupId = "%s"
+			providerName = "AWS"
+			region = "us-east-1"
+		}
+	`, testGroupId)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoatlasPrivateEndpointDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccMongoatlasPrivateEndpointConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoatlasPrivateEndpointExists("mongoatlas_private_endpoint.acceptancetest_endpoint", &privateendpoint),
+					resource.TestCheckResourceAttr(
+						"mongoatlas_private_endpoint.acceptancetest_endpoint", "region", "us-east-1"),
+					resource.TestCheckResourceAttrSet(
+						"mongoatlas_private_endpoint.acceptancetest_endpoint", "endpointServiceName"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccCheckMongoatlasPrivateEndpointDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*MongoatlasClient)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mongoatlas_private_endpoint" {
+			continue
+		}
+
+		privateendpoint, err := getPrivateEndpoint(client, rs.Primary.Attributes["groupId"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if privateendpoint != nil {
+			return fmt.Errorf("Private endpoint %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckMongoatlasPrivateEndpointExists(n string, privateendpoint *PrivateEndpoint) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No private endpoint ID is set")
+		}
+
+		client := testAccProvider.Meta().(*MongoatlasClient)
+		found, err := getPrivateEndpoint(client, rs.Primary.Attributes["groupId"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if found == nil {
+			return fmt.Errorf("Private endpoint %s not found", rs.Primary.ID)
+		}
+
+		*privateendpoint = *found
+		return nil
+	}
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"io/ioutil"
+	"log"
+)
+
+type Project struct {
+	Id           string `json:"id,omitempty"`
+	Name         string `json:"name,omitempty"`
+	OrgId        string `json:"orgId,omitempty"`
+	ClusterCount int    `json:"clusterCount,omitempty"`
+	Created      string `json:"created,omitempty"`
+}
+
+func resourceProject() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceProjectCreate,
+		Update: resourceProjectUpdate,
+		Read:   resourceProjectRead,
+		Delete: resourceProjectDelete,
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"orgId": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"clusterCount": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"created": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceProjectCreate(d *schema.ResourceData, m interface{}) error {
+	// Instantiate the MongoatlasClient. Source in client.go, it handles all the HTTP REST API communication
+	client := m.(*MongoatlasClient)
+
+	project := &Project{
+		Name:  d.Get("name").(string),
+		OrgId: d.Get("orgId").(string),
+	}
+
+	var jsonbuffer []byte
+
+	jsonpayload := bytes.NewBuffer(jsonbuffer)
+	enc := json.NewEncoder(jsonpayload)
+	enc.Encode(project)
+
+	log.Printf("Sending %s \n", jsonpayload)
+
+	// communication with API commence here
+	project_req, err := client.Post("groups", jsonpayload)
+	if err != nil {
+		return err
+	}
+
+	if project_req.StatusCode != 201 {
+		body, err := ioutil.ReadAll(project_req.Body)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("Failed to create project. Got the following response body %s", string(body))
+	}
+
+	decoder := json.NewDecoder(project_req.Body)
+	err = decoder.Decode(&project)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(project.Id)
+
+	return resourceProjectRead(d, m)
+}
+
+func resourceProjectRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	project_req, err := client.Get(fmt.Sprintf("groups/%s", d.Id()))
+	if err != nil {
+		return err
+	}
+
+	if project_req.StatusCode == 404 {
+		log.Printf("[DEBUG] project %s no longer exist, so we'll drop it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if project_req.StatusCode != 200 {
+		body, err := ioutil.ReadAll(project_req.Body)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("Failed to read project. Got the following response body %s", string(body))
+	}
+
+	var project Project
+
+	decoder := json.NewDecoder(project_req.Body)
+	err = decoder.Decode(&project)
+	if err != nil {
+		return err
+	}
+	log.Printf("Received %+v \n", project)
+
+	d.Set("name", project.Name)
+	d.Set("orgId", project.OrgId)
+	d.Set("clusterCount", project.ClusterCount)
+	d.Set("created", project.Created)
+
+	return nil
+}
+
+func resourceProjectUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	project := Project{}
+
+	if d.HasChange("name") {
+		project.Name = d.Get("name").(string)
+	}
+
+	var jsonbuffer []byte
+
+	jsonpayload := bytes.NewBuffer(jsonbuffer)
+	enc := json.NewEncoder(jsonpayload)
+	enc.Encode(project)
+
+	log.Printf("Sending %s \n", jsonpayload)
+
+	project_req, err := client.Patch(fmt.Sprintf("groups/%s", d.Id()), jsonpayload)
+	if err != nil {
+		return err
+	}
+
+	if project_req.StatusCode != 200 {
+		body, err := ioutil.ReadAll(project_req.Body)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("Failed to patch project: %s", string(body))
+	}
+
+	return resourceProjectRead(d, m)
+}
+
+func resourceProjectDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	delete_response, err := client.Delete(fmt.Sprintf("groups/%s", d.Id()))
+	if err != nil {
+		return err
+	}
+
+	// Atlas refuses to delete a project that still holds clusters, the body explains why
+	if delete_response.StatusCode != 200 && delete_response.StatusCode != 202 && delete_response.StatusCode != 204 && delete_response.StatusCode != 404 {
+		body, err := ioutil.ReadAll(delete_response.Body)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("Failed to delete the project. Got the following response body %s", string(body))
+	}
+	return nil
+}
@@ -0,0 +1,271 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"io/ioutil"
+	"log"
+	"strings"
+)
+
+// mongoatlas_project_access_list owns the whole whitelist of a project. Read
+// stores every entry Atlas knows about, so entries added outside of terraform
+// show up in the plan as entries to remove. It removes entries created by
+// mongoatlas_groupip_whitelist too, the two should not manage the same project.
+func resourceProjectAccessList() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceProjectAccessListCreate,
+		Update:        resourceProjectAccessListUpdate,
+		Read:          resourceProjectAccessListRead,
+		Delete:        resourceProjectAccessListDelete,
+		CustomizeDiff: resourceProjectAccessListCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"groupId": groupIdSchema(),
+			"entry": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      accessListEntryHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// single addresses are written as /32 blocks, the way Atlas returns them
+						"cidrBlock": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateCidrBlock,
+						},
+						"awsSecurityGroup": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"comment": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func accessListEntryHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["cidrBlock"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["awsSecurityGroup"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["comment"].(string)))
+	return hashcode.String(buf.String())
+}
+
+// checkAccessListEntry makes sure an entry has exactly one address
+func checkAccessListEntry(cidrBlock, awsSecurityGroup string) error {
+	if (cidrBlock == "") == (awsSecurityGroup == "") {
+		return fmt.Errorf("Access list entries need exactly one of cidrBlock or awsSecurityGroup")
+	}
+	return nil
+}
+
+// resourceProjectAccessListCustomizeDiff checks the entries known at plan
+// time, those with unknown values are checked on apply.
+func resourceProjectAccessListCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	for _, raw := range d.Get("entry").(*schema.Set).List() {
+		entry := raw.(map[string]interface{})
+		cidrBlock := entry["cidrBlock"].(string)
+		awsSecurityGroup := entry["awsSecurityGroup"].(string)
+
+		if cidrBlock == config.UnknownVariableValue || awsSecurityGroup == config.UnknownVariableValue {
+			continue
+		}
+		if err := checkAccessListEntry(cidrBlock, awsSecurityGroup); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// expandAccessList keys the configured entries by whitelistEntryAddress
+func expandAccessList(entries *schema.Set) (map[string]GroupipWhitelist, error) {
+	result := map[string]GroupipWhitelist{}
+	for _, raw := range entries.List() {
+		entry := raw.(map[string]interface{})
+		whitelist := GroupipWhitelist{
+			CidrBlock:        entry["cidrBlock"].(string),
+			AwsSecurityGroup: entry["awsSecurityGroup"].(string),
+			Comment:          entry["comment"].(string),
+		}
+
+		if err := checkAccessListEntry(whitelist.CidrBlock, whitelist.AwsSecurityGroup); err != nil {
+			return nil, err
+		}
+		result[whitelistEntryAddress(whitelist)] = whitelist
+	}
+	return result, nil
+}
+
+func listAccessList(client *MongoatlasClient, groupId string) (map[string]GroupipWhitelist, error) {
+	results, err := client.GetAll(fmt.Sprintf("groups/%s/whitelist", groupId))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := map[string]GroupipWhitelist{}
+	for _, raw := range results {
+		var entry GroupipWhitelist
+		if err := json.Unmarshal(raw, &entry); err != nil {
+			return nil, err
+		}
+		if whitelistEntryExpired(entry) {
+			continue
+		}
+		entries[whitelistEntryAddress(entry)] = entry
+	}
+	return entries, nil
+}
+
+func deleteAccessListEntry(client *MongoatlasClient, groupId string, entry string) error {
+	address := strings.Replace(entry, "/", "%2F", -1)
+
+	delete_response, err := client.Delete(fmt.Sprintf("groups/%s/whitelist/%s",
+		groupId,
+		address,
+	))
+	if err != nil {
+		return err
+	}
+
+	if delete_response.StatusCode != 200 && delete_response.StatusCode != 204 && delete_response.StatusCode != 404 {
+		body, err := ioutil.ReadAll(delete_response.Body)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("Failed to delete access list entry %s. Got the following response body %s", entry, string(body))
+	}
+	return nil
+}
+
+// syncAccessList makes the project whitelist match the configured entries.
+// New and changed entries go in a single batch before anything is removed, so
+// addresses moving between entries never lose access.
+func syncAccessList(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	groupId := d.Get("groupId").(string)
+	desired, err := expandAccessList(d.Get("entry").(*schema.Set))
+	if err != nil {
+		return err
+	}
+
+	current, err := listAccessList(client, groupId)
+	if err != nil {
+		return err
+	}
+
+	additions := []GroupipWhitelist{}
+	for address, entry := range desired {
+		if existing, ok := current[address]; !ok || existing.Comment != entry.Comment {
+			additions = append(additions, entry)
+		}
+	}
+
+	if len(additions) > 0 {
+		requestobject := &RequestObject{
+			Requestarray: additions,
+		}
+
+		var jsonbuffer []byte
+
+		jsonpayload := bytes.NewBuffer(jsonbuffer)
+		enc := json.NewEncoder(jsonpayload)
+		enc.Encode(requestobject.Requestarray)
+
+		log.Printf("Sending %s \n", jsonpayload)
+
+		accesslist_req, err := client.Post(fmt.Sprintf("groups/%s/whitelist", groupId), jsonpayload)
+		if err != nil {
+			return err
+		}
+
+		if accesslist_req.StatusCode != 200 && accesslist_req.StatusCode != 201 {
+			body, err := ioutil.ReadAll(accesslist_req.Body)
+			if err != nil {
+				return err
+			}
+			return fmt.Errorf("Failed to add access list entries. Got the following response body %s", string(body))
+		}
+	}
+
+	for address := range current {
+		if _, ok := desired[address]; ok {
+			continue
+		}
+
+		log.Printf("[DEBUG] removing unmanaged access list entry %s", address)
+		if err := deleteAccessListEntry(client, groupId, address); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceProjectAccessListCreate(d *schema.ResourceData, m interface{}) error {
+	if err := syncAccessList(d, m); err != nil {
+		return err
+	}
+
+	d.SetId(d.Get("groupId").(string))
+
+	return resourceProjectAccessListRead(d, m)
+}
+
+func resourceProjectAccessListRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	current, err := listAccessList(client, d.Get("groupId").(string))
+	if err != nil {
+		return err
+	}
+
+	entries := []map[string]interface{}{}
+	for _, entry := range current {
+		entries = append(entries, map[string]interface{}{
+			"cidrBlock":        entry.CidrBlock,
+			"awsSecurityGroup": entry.AwsSecurityGroup,
+			"comment":          entry.Comment,
+		})
+	}
+
+	if err := d.Set("entry", entries); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceProjectAccessListUpdate(d *schema.ResourceData, m interface{}) error {
+	if err := syncAccessList(d, m); err != nil {
+		return err
+	}
+
+	return resourceProjectAccessListRead(d, m)
+}
+
+func resourceProjectAccessListDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	entries, err := expandAccessList(d.Get("entry").(*schema.Set))
+	if err != nil {
+		return err
+	}
+
+	for address := range entries {
+		if err := deleteAccessListEntry(client, d.Get("groupId").(string), address); err != nil {
+			return err
+		}
+	}
+	return nil
+}
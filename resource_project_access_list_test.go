@@ -0,0 +1,135 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccMongoatlasProjectAccessList_basic(t *testing.T) {
+	testGroupId := os.Getenv("MONGOATLAS_GROUPID")
+
+	testAccMongoatlasProjectAccessListConfig := func(comment string) string {
+		return fmt.Sprintf(
+			`resource "mongoatlas_project_access_list" "acceptancetest_accesslist" {
+				groupId = "%s"
+				entry {
+					cidrBlock = "1.2.3.4/32"
+					comment = "%s"
+				}
+				entry {
+					cidrBlock = "10.10.0.0/16"
+				}
+			}
+		`, testGroupId, comment)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoatlasProjectAccessListDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccMongoatlasProjectAccessListConfig("terraform test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoatlasProjectAccessListExists("mongoatlas_project_access_list.acceptancetest_accesslist", 2),
+					resource.TestCheckResourceAttr(
+						"mongoatlas_project_access_list.acceptancetest_accesslist", "entry.#", "2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccMongoatlasProjectAccessListConfig("terraform test updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoatlasProjectAccessListExists("mongoatlas_project_access_list.acceptancetest_accesslist", 2),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccCheckMongoatlasProjectAccessListDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*MongoatlasClient)
+	rs, ok := s.RootModule().Resources["mongoatlas_project_access_list.acceptancetest_accesslist"]
+	if !ok {
+		return fmt.Errorf("Not found %s", "mongoatlas_project_access_list.acceptancetest_accesslist")
+	}
+
+	entries, err := listAccessList(client, rs.Primary.Attributes["groupId"])
+	if err != nil {
+		return err
+	}
+
+	if len(entries) != 0 {
+		return fmt.Errorf("Access list still has %d entries", len(entries))
+	}
+
+	return nil
+}
+
+func testAccCheckMongoatlasProjectAccessListExists(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No access list ID is set")
+		}
+
+		client := testAccProvider.Meta().(*MongoatlasClient)
+		entries, err := listAccessList(client, rs.Primary.Attributes["groupId"])
+		if err != nil {
+			return err
+		}
+
+		if len(entries) != count {
+			return fmt.Errorf("Expected %d access list entries, got %d", count, len(entries))
+		}
+		return nil
+	}
+}
+
+func TestAccMongoAtlasProjectAccessListEntry_validation(t *testing.T) {
+	cases := []struct {
+		Value    map[string]interface{}
+		ErrCount int
+	}{
+		{
+			Value:    map[string]interface{}{"cidrBlock": "1.2.3.0/24"},
+			ErrCount: 0,
+		},
+		{
+			Value:    map[string]interface{}{"awsSecurityGroup": "sg-123456"},
+			ErrCount: 0,
+		},
+		{
+			Value:    map[string]interface{}{"comment": "no address"},
+			ErrCount: 1,
+		},
+		{
+			Value:    map[string]interface{}{"cidrBlock": "1.2.3.0/24", "awsSecurityGroup": "sg-123456"},
+			ErrCount: 1,
+		},
+		{
+			Value:    map[string]interface{}{"awsSecurityGroup": config.UnknownVariableValue},
+			ErrCount: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		errCount := 0
+		raw := map[string]interface{}{"entry": []interface{}{tc.Value}}
+		if err := testResourceDiff(resourceProjectAccessList(), raw); err != nil {
+			errCount = 1
+		}
+
+		if errCount != tc.ErrCount {
+			t.Fatalf("Expected %+v Validation Error, Got %+v Validation Error for %+v VALUE", tc.ErrCount, errCount, tc.Value)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testAccProjectPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+	if v := os.Getenv("MONGOATLAS_ORGID"); v == "" {
+		t.Fatal("MONGOATLAS_ORGID must be set for project acceptance tests")
+	}
+}
+
+func TestAccMongoatlasProject_basic(t *testing.T) {
+	var project Project
+
+	testOrgId := os.Getenv("MONGOATLAS_ORGID")
+
+	testAccMongoatlasProjectConfig := fmt.Sprintf(
+		`resource "mongoatlas_project" "acceptancetest_project" {
+			name = "terratest-project"
+			orgId = "%s"
+		}
+
+		data "mongoatlas_project" "acceptancetest_project" {
+			name = "${mongoatlas_project.acceptancetest_project.name}"
+		}
+	`, testOrgId)
+
+	testAccMongoatlasProjectConfig_update := fmt.Sprintf(
+		`resource "mongoatlas_project" "acceptancetest_project" {
+			name = "terratest-project-renamed"
+			orgId = "%s"
+		}
+	`, testOrgId)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccProjectPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoatlasProjectDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccMongoatlasProjectConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoatlasProjectExists("mongoatlas_project.acceptancetest_project", &project),
+					resource.TestCheckResourceAttrPair(
+						"data.mongoatlas_project.acceptancetest_project", "id",
+						"mongoatlas_project.acceptancetest_project", "id"),
+				),
+			},
+
+			resource.TestStep{
+				Config: testAccMongoatlasProjectConfig_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoatlasProjectExists("mongoatlas_project.acceptancetest_project", &project),
+					resource.TestCheckResourceAttr(
+						"mongoatlas_project.acceptancetest_project", "name", "terratest-project-renamed"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccCheckMongoatlasProjectDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*MongoatlasClient)
+	rs, ok := s.RootModule().Resources["mongoatlas_project.acceptancetest_project"]
+	if !ok {
+		return fmt.Errorf("Not found %s", "mongoatlas_project.acceptancetest_project")
+	}
+
+	response, err := client.Get(fmt.Sprintf("groups/%s", rs.Primary.ID))
+
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode != 404 {
+		return fmt.Errorf("Project still exists")
+	}
+
+	return nil
+}
+
+func testAccCheckMongoatlasProjectExists(n string, project *Project) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No project ID is set")
+		}
+		return nil
+	}
+}
@@ -0,0 +1,286 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+)
+
+type ThirdPartyIntegration struct {
+	Type        string `json:"type,omitempty"`
+	ApiToken    string `json:"apiToken,omitempty"`
+	TeamName    string `json:"teamName,omitempty"`
+	ChannelName string `json:"channelName,omitempty"`
+	ServiceKey  string `json:"serviceKey,omitempty"`
+	ApiKey      string `json:"apiKey,omitempty"`
+	Region      string `json:"region,omitempty"`
+	Url         string `json:"url,omitempty"`
+	Secret      string `json:"secret,omitempty"`
+}
+
+// thirdPartyIntegrationFields lists the arguments each integration type
+// requires, the others are rejected.
+var thirdPartyIntegrationFields = map[string][]string{
+	"SLACK":      {"apiToken", "teamName", "channelName"},
+	"PAGER_DUTY": {"serviceKey"},
+	"DATADOG":    {"apiKey", "region"},
+	"WEBHOOK":    {"url"},
+}
+
+func resourceThirdPartyIntegration() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceThirdPartyIntegrationCreate,
+		Update:        resourceThirdPartyIntegrationUpdate,
+		Read:          resourceThirdPartyIntegrationRead,
+		Delete:        resourceThirdPartyIntegrationDelete,
+		CustomizeDiff: resourceThirdPartyIntegrationCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"groupId": groupIdSchema(),
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIntegrationType,
+			},
+			"apiToken": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"teamName": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"channelName": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"serviceKey": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"apiKey": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"url": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"secret": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceThirdPartyIntegrationCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	return checkThirdPartyIntegrationFields(d, d.NewValueKnown)
+}
+
+// checkThirdPartyIntegrationFields checks the arguments set against the ones
+// the integration type takes. Arguments known reports false for, e.g. values
+// interpolated from resources not created yet, are left to the apply time check.
+func checkThirdPartyIntegrationFields(d interface {
+	Get(string) interface{}
+	GetOk(string) (interface{}, bool)
+}, known func(string) bool) error {
+	if !known("type") {
+		return nil
+	}
+
+	integrationType := d.Get("type").(string)
+	fields, ok := thirdPartyIntegrationFields[integrationType]
+	if !ok {
+		return nil
+	}
+
+	for _, field := range []string{"apiToken", "teamName", "channelName", "serviceKey", "apiKey", "region", "url"} {
+		if !known(field) {
+			continue
+		}
+		_, set := d.GetOk(field)
+		if stringInSlice(field, fields) && !set {
+			return fmt.Errorf("%s is required for %s integrations", field, integrationType)
+		}
+		if !stringInSlice(field, fields) && set {
+			return fmt.Errorf("%s cannot be set on %s integrations", field, integrationType)
+		}
+	}
+
+	// secret is optional and only means something for webhooks
+	if _, set := d.GetOk("secret"); set && known("secret") && integrationType != "WEBHOOK" {
+		return fmt.Errorf("secret cannot be set on %s integrations", integrationType)
+	}
+
+	return nil
+}
+
+func newThirdPartyIntegration(d *schema.ResourceData) *ThirdPartyIntegration {
+	return &ThirdPartyIntegration{
+		Type:        d.Get("type").(string),
+		ApiToken:    d.Get("apiToken").(string),
+		TeamName:    d.Get("teamName").(string),
+		ChannelName: d.Get("channelName").(string),
+		ServiceKey:  d.Get("serviceKey").(string),
+		ApiKey:      d.Get("apiKey").(string),
+		Region:      d.Get("region").(string),
+		Url:         d.Get("url").(string),
+		Secret:      d.Get("secret").(string),
+	}
+}
+
+// redactedSecret picks the value to store for a secret Atlas only returns
+// redacted, e.g. "****************abcd". The state value is kept while the
+// visible characters still match it, otherwise the redacted value is stored
+// so the next plan shows the secret as changed.
+func redactedSecret(stateValue, apiValue string) string {
+	if apiValue == stateValue || !strings.Contains(apiValue, "*") {
+		return apiValue
+	}
+
+	visible := strings.TrimLeft(apiValue, "*")
+	if stateValue != "" && strings.HasSuffix(stateValue, visible) {
+		return stateValue
+	}
+	return apiValue
+}
+
+func putThirdPartyIntegration(d *schema.ResourceData, m interface{}, create bool) error {
+	client := m.(*MongoatlasClient)
+
+	// every value is known by now, including the ones skipped while planning
+	if err := checkThirdPartyIntegrationFields(d, func(string) bool { return true }); err != nil {
+		return err
+	}
+
+	integration := newThirdPartyIntegration(d)
+
+	var jsonbuffer []byte
+
+	jsonpayload := bytes.NewBuffer(jsonbuffer)
+	enc := json.NewEncoder(jsonpayload)
+	enc.Encode(integration)
+
+	// the payload carries the integration secrets, so it is not logged
+	log.Printf("Configuring %s integration \n", integration.Type)
+
+	endpoint := fmt.Sprintf("groups/%s/integrations/%s",
+		d.Get("groupId").(string),
+		integration.Type,
+	)
+
+	var err error
+	var integration_req *http.Response
+	if create {
+		integration_req, err = client.Post(endpoint, jsonpayload)
+	} else {
+		integration_req, err = client.Put(endpoint, jsonpayload)
+	}
+	if err != nil {
+		return err
+	}
+
+	if integration_req.StatusCode != 200 && integration_req.StatusCode != 201 {
+		body, err := ioutil.ReadAll(integration_req.Body)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("Failed to configure %s integration. Got the following response body %s", integration.Type, string(body))
+	}
+
+	return nil
+}
+
+func resourceThirdPartyIntegrationCreate(d *schema.ResourceData, m interface{}) error {
+	if err := putThirdPartyIntegration(d, m, true); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s-%s", d.Get("groupId").(string), d.Get("type").(string)))
+
+	return resourceThirdPartyIntegrationRead(d, m)
+}
+
+func resourceThirdPartyIntegrationRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	integration_req, err := client.Get(fmt.Sprintf("groups/%s/integrations/%s",
+		d.Get("groupId").(string),
+		d.Get("type").(string),
+	))
+	if err != nil {
+		return err
+	}
+
+	if integration_req.StatusCode == 404 {
+		log.Printf("[DEBUG] %s integration no longer exist, so we'll drop it from the state", d.Get("type").(string))
+		d.SetId("")
+		return nil
+	}
+
+	if integration_req.StatusCode != 200 {
+		body, err := ioutil.ReadAll(integration_req.Body)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("Failed to read %s integration. Got the following response body %s", d.Get("type").(string), string(body))
+	}
+
+	var integration ThirdPartyIntegration
+
+	decoder := json.NewDecoder(integration_req.Body)
+	err = decoder.Decode(&integration)
+	if err != nil {
+		return err
+	}
+
+	d.Set("teamName", integration.TeamName)
+	d.Set("channelName", integration.ChannelName)
+	d.Set("region", integration.Region)
+	d.Set("url", integration.Url)
+	d.Set("apiToken", redactedSecret(d.Get("apiToken").(string), integration.ApiToken))
+	d.Set("serviceKey", redactedSecret(d.Get("serviceKey").(string), integration.ServiceKey))
+	d.Set("apiKey", redactedSecret(d.Get("apiKey").(string), integration.ApiKey))
+	d.Set("secret", redactedSecret(d.Get("secret").(string), integration.Secret))
+
+	return nil
+}
+
+func resourceThirdPartyIntegrationUpdate(d *schema.ResourceData, m interface{}) error {
+	if err := putThirdPartyIntegration(d, m, false); err != nil {
+		return err
+	}
+
+	return resourceThirdPartyIntegrationRead(d, m)
+}
+
+func resourceThirdPartyIntegrationDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	delete_response, err := client.Delete(fmt.Sprintf("groups/%s/integrations/%s",
+		d.Get("groupId").(string),
+		d.Get("type").(string),
+	))
+	if err != nil {
+		return err
+	}
+
+	if delete_response.StatusCode != 200 && delete_response.StatusCode != 204 && delete_response.StatusCode != 404 {
+		return fmt.Errorf("Failed to delete the %s integration. Got status code %d", d.Get("type").(string), delete_response.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccMongoatlasThirdPartyIntegration_basic(t *testing.T) {
+	var integration ThirdPartyIntegration
+
+	testGroupId := os.Getenv("MONGOATLAS_GROUPID")
+
+	testAccMongoatlasThirdPartyIntegrationConfig := fmt.Sprintf(
+		`resource "mongoatlas_third_party_integration" "acceptancetest_integration" {
+			groupId = "%s"
+			type = "WEBHOOK"
+			url = "https://example.com/terratest"
+			secret = "terratest-secret"
+		}
+	`, testGroupId)
+
+	testAccMongoatlasThirdPartyIntegrationConfig_update := fmt.Sprintf(
+		`resource "mongoatlas_third_party_integration" "acceptancetest_integration" {
+			groupId = "%s"
+			type = "WEBHOOK"
+			url = "https://example.com/terratest-updated"
+			secret = "terratest-secret"
+		}
+	`, testGroupId)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoatlasThirdPartyIntegrationDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccMongoatlasThirdPartyIntegrationConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoatlasThirdPartyIntegrationExists("mongoatlas_third_party_integration.acceptancetest_integration", &integration),
+					resource.TestCheckResourceAttr(
+						"mongoatlas_third_party_integration.acceptancetest_integration", "secret", "terratest-secret"),
+				),
+			},
+
+			resource.TestStep{
+				Config: testAccMongoatlasThirdPartyIntegrationConfig_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoatlasThirdPartyIntegrationExists("mongoatlas_third_party_integration.acceptancetest_integration", &integration),
+					resource.TestCheckResourceAttr(
+						"mongoatlas_third_party_integration.acceptancetest_integration", "url", "https://example.com/terratest-updated"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccCheckMongoatlasThirdPartyIntegrationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*MongoatlasClient)
+	rs, ok := s.RootModule().Resources["mongoatlas_third_party_integration.acceptancetest_integration"]
+	if !ok {
+		return fmt.Errorf("Not found %s", "mongoatlas_third_party_integration.acceptancetest_integration")
+	}
+
+	response, err := client.Get(fmt.Sprintf("groups/%s/integrations/%s", rs.Primary.Attributes["groupId"], rs.Primary.Attributes["type"]))
+
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode != 404 {
+		return fmt.Errorf("ThirdPartyIntegration still exists")
+	}
+
+	return nil
+}
+
+func testAccCheckMongoatlasThirdPartyIntegrationExists(n string, integration *ThirdPartyIntegration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No third party integration ID is set")
+		}
+		return nil
+	}
+}
+
+func TestAccMongoAtlasThirdPartyIntegrationRedactedSecret(t *testing.T) {
+	cases := []struct {
+		State    string
+		Api      string
+		Expected string
+	}{
+		{
+			State:    "0123456789abcdef",
+			Api:      "************cdef",
+			Expected: "0123456789abcdef",
+		},
+		{
+			State:    "0123456789abcdef",
+			Api:      "************9999",
+			Expected: "************9999",
+		},
+		{
+			State:    "",
+			Api:      "************cdef",
+			Expected: "************cdef",
+		},
+		{
+			State:    "0123456789abcdef",
+			Api:      "0123456789abcdef",
+			Expected: "0123456789abcdef",
+		},
+		{
+			State:    "0123456789abcdef",
+			Api:      "",
+			Expected: "",
+		},
+	}
+
+	for _, tc := range cases {
+		if value := redactedSecret(tc.State, tc.Api); value != tc.Expected {
+			t.Fatalf("Expected %q, got %q for state %q and api %q", tc.Expected, value, tc.State, tc.Api)
+		}
+	}
+}
+
+func TestAccMongoAtlasThirdPartyIntegrationFields_validation(t *testing.T) {
+	cases := []struct {
+		Value    map[string]interface{}
+		ErrCount int
+	}{
+		{
+			Value:    map[string]interface{}{"type": "SLACK", "apiToken": "token", "teamName": "team", "channelName": "channel"},
+			ErrCount: 0,
+		},
+		{
+			Value:    map[string]interface{}{"type": "SLACK", "apiToken": "token", "teamName": "team"},
+			ErrCount: 1,
+		},
+		{
+			Value:    map[string]interface{}{"type": "SLACK", "apiToken": config.UnknownVariableValue, "teamName": "team", "channelName": "channel"},
+			ErrCount: 0,
+		},
+		{
+			Value:    map[string]interface{}{"type": "DATADOG", "apiKey": "key", "region": "US", "url": "https://example.com"},
+			ErrCount: 1,
+		},
+		{
+			Value:    map[string]interface{}{"type": "WEBHOOK", "url": config.UnknownVariableValue, "secret": config.UnknownVariableValue},
+			ErrCount: 0,
+		},
+		{
+			Value:    map[string]interface{}{"type": "PAGER_DUTY", "serviceKey": "key", "secret": "secret"},
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		errCount := 0
+		if err := testResourceDiff(resourceThirdPartyIntegration(), tc.Value); err != nil {
+			errCount = 1
+		}
+
+		if errCount != tc.ErrCount {
+			t.Fatalf("Expected %+v Validation Error, Got %+v Validation Error for %+v VALUE", tc.ErrCount, errCount, tc.Value)
+		}
+	}
+}
@@ -7,40 +7,120 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"log"
 	"io/ioutil"
+	"strings"
 )
 
 type VpcPeering struct {
+	ProviderName        string `json:"providerName,omitempty"`
+	ContainerId         string `json:"containerId,omitempty"`
 	VpcId               string `json:"vpcId,omitempty"`
 	AwsAccountId        string `json:"awsAccountId,omitempty"`
 	RouteTableCidrBlock string `json:"routeTableCidrBlock,omitempty"`
+	GcpProjectId        string `json:"gcpProjectId,omitempty"`
+	NetworkName         string `json:"networkName,omitempty"`
+	AtlasCidrBlock      string `json:"atlasCidrBlock,omitempty"`
+	AzureDirectoryId    string `json:"azureDirectoryId,omitempty"`
+	AzureSubscriptionId string `json:"azureSubscriptionId,omitempty"`
+	ResourceGroupName   string `json:"resourceGroupName,omitempty"`
+	VnetName            string `json:"vnetName,omitempty"`
 	Id                  string `json:"id,omitempty"`
 	ConnectionId        string `json:"connectionId,omitempty"`
 	StatusName          string `json:"statusName,omitempty"`
 	ErrorStateName      string `json:"errorStateName,omitempty"` // TODO: Must handle ErrorStateName as it is empty if everything goes smoothly
+	// GCP and Azure peers report their state here instead of statusName and errorStateName
+	Status       string `json:"status,omitempty"`
+	ErrorMessage string `json:"errorMessage,omitempty"`
+}
+
+// vpcPeeringFields lists the arguments each cloud provider requires. Only the
+// arguments of one provider can be set on a peering.
+var vpcPeeringFields = map[string][]string{
+	"AWS":   {"vpcId", "awsAccountId", "routeTableCidrBlock"},
+	"GCP":   {"gcpProjectId", "networkName"},
+	"AZURE": {"azureDirectoryId", "azureSubscriptionId", "resourceGroupName", "vnetName"},
+}
+
+// vpcPeeringConflicts returns the arguments of every provider but the given one
+func vpcPeeringConflicts(providerName string) []string {
+	conflicts := []string{}
+	for _, name := range []string{"AWS", "GCP", "AZURE"} {
+		if name != providerName {
+			conflicts = append(conflicts, vpcPeeringFields[name]...)
+		}
+	}
+	return conflicts
 }
 
 func resourceVpcPeering() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceVpcPeeringCreate,
-		Update: resourceVpcPeeringUpdate,
-		Read:   resourceVpcPeeringRead,
-		Delete: resourceVpcPeeringDelete,
+		Create:        resourceVpcPeeringCreate,
+		Update:        resourceVpcPeeringUpdate,
+		Read:          resourceVpcPeeringRead,
+		Delete:        resourceVpcPeeringDelete,
+		CustomizeDiff: resourceVpcPeeringCustomizeDiff,
 		Schema: map[string]*schema.Schema{
-			"groupId": &schema.Schema{
+			"groupId": groupIdSchema(),
+			"providerName": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
+				Computed: true,
 			},
-			"vpcId": &schema.Schema{
+			"containerId": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"vpcId": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: vpcPeeringConflicts("AWS"),
 			},
 			"awsAccountId": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: vpcPeeringConflicts("AWS"),
 			},
 			"routeTableCidrBlock": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: vpcPeeringConflicts("AWS"),
+				ValidateFunc:  validateCidrBlock,
+			},
+			"gcpProjectId": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: vpcPeeringConflicts("GCP"),
+			},
+			"networkName": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: vpcPeeringConflicts("GCP"),
+			},
+			"azureDirectoryId": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: vpcPeeringConflicts("AZURE"),
+			},
+			"azureSubscriptionId": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: vpcPeeringConflicts("AZURE"),
+			},
+			"resourceGroupName": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: vpcPeeringConflicts("AZURE"),
+			},
+			"vnetName": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: vpcPeeringConflicts("AZURE"),
 			},
 			"id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -62,11 +142,118 @@ func resourceVpcPeering() *schema.Resource {
 	}
 }
 
+// vpcPeeringProvider works out the cloud provider from the arguments set. No
+// provider is only an error once known reports every provider argument known,
+// as the ones still unknown at plan time may turn out to be set.
+func vpcPeeringProvider(d interface {
+	GetOk(string) (interface{}, bool)
+}, known func(string) bool) (string, error) {
+	providers := []string{}
+	allKnown := true
+	for _, providerName := range []string{"AWS", "GCP", "AZURE"} {
+		set := false
+		for _, field := range vpcPeeringFields[providerName] {
+			if _, ok := d.GetOk(field); ok {
+				set = true
+			}
+			if !known(field) {
+				allKnown = false
+			}
+		}
+		if set {
+			providers = append(providers, providerName)
+		}
+	}
+
+	switch {
+	case len(providers) == 1:
+		return providers[0], nil
+	case len(providers) > 1:
+		return "", fmt.Errorf("Only the arguments of one provider can be set, got %s", strings.Join(providers, ", "))
+	case allKnown:
+		return "", fmt.Errorf("One of the AWS, GCP or Azure peering arguments must be set")
+	}
+	return "", nil
+}
+
+// vpcPeeringProviderName also checks every argument of the provider is set.
+// Those missing can only be told apart from unknown values once applying.
+func vpcPeeringProviderName(d *schema.ResourceData) (string, error) {
+	providerName, err := vpcPeeringProvider(d, func(string) bool { return true })
+	if err != nil {
+		return "", err
+	}
+
+	for _, field := range vpcPeeringFields[providerName] {
+		if _, ok := d.GetOk(field); !ok {
+			return "", fmt.Errorf("%s is required for %s peerings", field, providerName)
+		}
+	}
+	if providerName != "AWS" {
+		if _, ok := d.GetOk("containerId"); !ok {
+			return "", fmt.Errorf("containerId is required for %s peerings", providerName)
+		}
+	}
+	return providerName, nil
+}
+
+// resourceVpcPeeringCustomizeDiff refuses a peering mixing providers or, once
+// its arguments are known, without any provider arguments. It also refuses a
+// routeTableCidrBlock overlapping the Atlas CIDR block of the container, or of
+// any AWS container in the project when containerId is not set. Values unknown
+// at plan time are left to Atlas.
+func resourceVpcPeeringCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if _, err := vpcPeeringProvider(d, d.NewValueKnown); err != nil {
+		return err
+	}
+
+	if !d.HasChange("routeTableCidrBlock") && !d.HasChange("containerId") {
+		return nil
+	}
+
+	routeTableCidrBlock, ok := d.GetOk("routeTableCidrBlock")
+	if !ok {
+		return nil
+	}
+	groupId, ok := d.GetOk("groupId")
+	if !ok {
+		return nil
+	}
+
+	client := m.(*MongoatlasClient)
+
+	containers, err := listContainers(client, groupId.(string), "AWS", "")
+	if err != nil {
+		return err
+	}
+
+	containerId, filtered := d.GetOk("containerId")
+	for _, container := range containers {
+		if filtered && container.Id != containerId.(string) {
+			continue
+		}
+
+		if cidrBlocksOverlap(routeTableCidrBlock.(string), container.AtlasCidrBlock) {
+			return fmt.Errorf("routeTableCidrBlock %s overlaps the atlasCidrBlock %s of container %s",
+				routeTableCidrBlock.(string), container.AtlasCidrBlock, container.Id)
+		}
+	}
+
+	return nil
+}
+
 func newVpcPeering(d *schema.ResourceData) *VpcPeering {
 	vpcpeering := &VpcPeering{
+		ContainerId:         d.Get("containerId").(string),
 		VpcId:               d.Get("vpcId").(string),
 		AwsAccountId:        d.Get("awsAccountId").(string),
 		RouteTableCidrBlock: d.Get("routeTableCidrBlock").(string),
+		GcpProjectId:        d.Get("gcpProjectId").(string),
+		NetworkName:         d.Get("networkName").(string),
+		AzureDirectoryId:    d.Get("azureDirectoryId").(string),
+		AzureSubscriptionId: d.Get("azureSubscriptionId").(string),
+		ResourceGroupName:   d.Get("resourceGroupName").(string),
+		VnetName:            d.Get("vnetName").(string),
 	}
 
 	return vpcpeering
@@ -77,7 +264,42 @@ func resourceVpcPeeringCreate(d *schema.ResourceData, m interface{}) error {
 	// Instantiate the MongoatlasClient. Source in client.go, it handles all the HTTP REST API communication
 	client := m.(*MongoatlasClient)
 
+	providerName, err := vpcPeeringProviderName(d)
+	if err != nil {
+		return err
+	}
+
 	vpcpeering := newVpcPeering(d)
+	vpcpeering.ProviderName = providerName
+
+	// Azure peers also need the Atlas side CIDR block, which lives on the container
+	if providerName == "AZURE" {
+		container_req, err := client.Get(fmt.Sprintf("groups/%s/containers/%s",
+			d.Get("groupId").(string),
+			vpcpeering.ContainerId,
+		))
+		if err != nil {
+			return err
+		}
+
+		if container_req.StatusCode != 200 {
+			body, err := ioutil.ReadAll(container_req.Body)
+			if err != nil {
+				return err
+			}
+			return fmt.Errorf("Failed to read container %s. Got the following response body %s", vpcpeering.ContainerId, string(body))
+		}
+
+		var container Container
+
+		decoder := json.NewDecoder(container_req.Body)
+		err = decoder.Decode(&container)
+		if err != nil {
+			return err
+		}
+		vpcpeering.AtlasCidrBlock = container.AtlasCidrBlock
+	}
+
 	var jsonbuffer []byte
 
 	jsonpayload := bytes.NewBuffer(jsonbuffer)
@@ -90,15 +312,10 @@ func resourceVpcPeeringCreate(d *schema.ResourceData, m interface{}) error {
 	vpcpeering_req, err := client.Post(fmt.Sprintf("groups/%s/peers",
 		d.Get("groupId").(string),
 	), jsonpayload)
-
-	decoder := json.NewDecoder(vpcpeering_req.Body)
-	err = decoder.Decode(&vpcpeering)
 	if err != nil {
 		return err
 	}
 
-	log.Printf("Received %s \n", vpcpeering_req.Body)
-
 	// if statuscode is different than 201, nothing is persisted in the tfstate file, but what happened on mongo atlas is not checked.
 	// TODO: handle other status code
 	if vpcpeering_req.StatusCode != 201 {
@@ -106,7 +323,13 @@ func resourceVpcPeeringCreate(d *schema.ResourceData, m interface{}) error {
 		if err != nil {
 			return err
 		}
-		return fmt.Errorf("Failed to create vpc peering got status code %d", string(body))
+		return fmt.Errorf("Failed to create vpc peering. Got the following response body %s", string(body))
+	}
+
+	decoder := json.NewDecoder(vpcpeering_req.Body)
+	err = decoder.Decode(&vpcpeering)
+	if err != nil {
+		return err
 	}
 
 	// The following statement saves set the data that will be saved in the .tfstate file
@@ -146,19 +369,37 @@ func resourceVpcPeeringRead(d *schema.ResourceData, m interface{}) error {
 	}
 	log.Printf("Received %s \n", vpcpeering_req.Body)
 
+	d.Set("providerName", vpcpeering.ProviderName)
+	d.Set("containerId", vpcpeering.ContainerId)
+	d.Set("vpcId", vpcpeering.VpcId)
 	d.Set("awsAccountId", vpcpeering.AwsAccountId)
 	d.Set("routeTableCidrBlock", vpcpeering.RouteTableCidrBlock)
+	d.Set("gcpProjectId", vpcpeering.GcpProjectId)
+	d.Set("networkName", vpcpeering.NetworkName)
+	d.Set("azureDirectoryId", vpcpeering.AzureDirectoryId)
+	d.Set("azureSubscriptionId", vpcpeering.AzureSubscriptionId)
+	d.Set("resourceGroupName", vpcpeering.ResourceGroupName)
+	d.Set("vnetName", vpcpeering.VnetName)
 	d.Set("id", vpcpeering.Id)
 	d.Set("connectionId", vpcpeering.ConnectionId)
-	d.Set("statusName", vpcpeering.StatusName)
-	d.Set("errorStateName", vpcpeering.ErrorStateName)
+
+	if vpcpeering.ProviderName == "AWS" || vpcpeering.ProviderName == "" {
+		d.Set("statusName", vpcpeering.StatusName)
+		d.Set("errorStateName", vpcpeering.ErrorStateName)
+	} else {
+		d.Set("statusName", vpcpeering.Status)
+		d.Set("errorStateName", vpcpeering.ErrorMessage)
+	}
 	return nil
 }
 
 func resourceVpcPeeringUpdate(d *schema.ResourceData, m interface{}) error {
 	client := m.(*MongoatlasClient)
 
-	vpcpeering := VpcPeering{}
+	// Atlas wants the provider on every patch, GCP and Azure arguments force a new peering
+	vpcpeering := VpcPeering{
+		ProviderName: d.Get("providerName").(string),
+	}
 
 	// TODO: VPCID and AWSACCOUNTID are handled together. On their own the changes are not applied
 	if d.HasChange("vpcId") {
@@ -203,8 +444,6 @@ func resourceVpcPeeringUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	return resourceVpcPeeringRead(d, m)
-
-	return nil
 }
 
 func resourceVpcPeeringDelete(d *schema.ResourceData, m interface{}) error {
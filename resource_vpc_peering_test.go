@@ -6,7 +6,9 @@ import (
 	"testing"
 
 	"encoding/json"
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -84,3 +86,162 @@ func testAccCheckMongoatlasVpcpeeringExists(n string, vpcpeering *VpcPeering) re
 		return nil
 	}
 }
+
+func TestAccMongoAtlasVpcPeeringProviderName_validation(t *testing.T) {
+	cases := []struct {
+		Value    map[string]interface{}
+		Provider string
+		ErrCount int
+	}{
+		{
+			Value:    map[string]interface{}{"vpcId": "vpc-123", "awsAccountId": "123456789012", "routeTableCidrBlock": "10.0.0.0/24"},
+			Provider: "AWS",
+			ErrCount: 0,
+		},
+		{
+			Value:    map[string]interface{}{"containerId": "abc", "gcpProjectId": "my-project", "networkName": "default"},
+			Provider: "GCP",
+			ErrCount: 0,
+		},
+		{
+			Value:    map[string]interface{}{"containerId": "abc", "azureDirectoryId": "dir", "azureSubscriptionId": "sub", "resourceGroupName": "rg", "vnetName": "vnet"},
+			Provider: "AZURE",
+			ErrCount: 0,
+		},
+		{
+			Value:    map[string]interface{}{"vpcId": "vpc-123", "awsAccountId": "123456789012"},
+			ErrCount: 1,
+		},
+		{
+			Value:    map[string]interface{}{"gcpProjectId": "my-project", "networkName": "default"},
+			ErrCount: 1,
+		},
+		{
+			Value:    map[string]interface{}{},
+			ErrCount: 1,
+		},
+		{
+			Value:    map[string]interface{}{"vpcId": "vpc-123", "awsAccountId": "123456789012", "routeTableCidrBlock": "10.0.0.0/24", "containerId": "abc", "gcpProjectId": "my-project", "networkName": "default"},
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, resourceVpcPeering().Schema, tc.Value)
+		provider, err := vpcPeeringProviderName(d)
+
+		errCount := 0
+		if err != nil {
+			errCount = 1
+		}
+		if errCount != tc.ErrCount || provider != tc.Provider {
+			t.Fatalf("Expected %+v Validation Error and provider %q, Got %+v Validation Error and provider %q for %+v VALUE", tc.ErrCount, tc.Provider, errCount, provider, tc.Value)
+		}
+	}
+}
+
+func TestAccMongoAtlasVpcPeeringProvider_validation(t *testing.T) {
+	cases := []struct {
+		Value    map[string]interface{}
+		ErrCount int
+	}{
+		{
+			Value:    map[string]interface{}{"vpcId": "vpc-123", "awsAccountId": "123456789012", "routeTableCidrBlock": "10.0.0.0/24"},
+			ErrCount: 0,
+		},
+		{
+			Value:    map[string]interface{}{"vpcId": config.UnknownVariableValue, "awsAccountId": config.UnknownVariableValue, "routeTableCidrBlock": config.UnknownVariableValue},
+			ErrCount: 0,
+		},
+		{
+			Value:    map[string]interface{}{"containerId": "abc", "networkName": config.UnknownVariableValue},
+			ErrCount: 0,
+		},
+		{
+			Value:    map[string]interface{}{"containerId": "abc"},
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		errCount := 0
+		if err := testResourceDiff(resourceVpcPeering(), tc.Value); err != nil {
+			errCount = 1
+		}
+
+		if errCount != tc.ErrCount {
+			t.Fatalf("Expected %+v Validation Error, Got %+v Validation Error for %+v VALUE", tc.ErrCount, errCount, tc.Value)
+		}
+	}
+}
+
+func TestAccMongoAtlasVpcPeeringRouteTableCidrBlock_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "10.230.8.0/24",
+			ErrCount: 0,
+		},
+		{
+			Value:    "0.0.0.0/0",
+			ErrCount: 0,
+		},
+		{
+			Value:    "10.230.8.1/24",
+			ErrCount: 1,
+		},
+		{
+			Value:    "10.230.8.0/33",
+			ErrCount: 1,
+		},
+		{
+			Value:    "vpc-123456",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateCidrBlock(tc.Value, "mongoatlas_vpc_peering_routetablecidrblock")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %+v Validation Error, Got %+v Validation Error for %+v VALUE", tc.ErrCount, len(errors), tc.Value)
+		}
+	}
+}
+
+func TestAccMongoAtlasCidrBlocksOverlap(t *testing.T) {
+	cases := []struct {
+		A       string
+		B       string
+		Overlap bool
+	}{
+		{
+			A:       "10.230.8.0/24",
+			B:       "10.230.0.0/21",
+			Overlap: false,
+		},
+		{
+			A:       "10.230.0.0/16",
+			B:       "10.230.0.0/21",
+			Overlap: true,
+		},
+		{
+			A:       "10.230.4.0/24",
+			B:       "10.230.0.0/21",
+			Overlap: true,
+		},
+		{
+			A:       "192.168.0.0/24",
+			B:       "10.230.0.0/21",
+			Overlap: false,
+		},
+	}
+
+	for _, tc := range cases {
+		if cidrBlocksOverlap(tc.A, tc.B) != tc.Overlap {
+			t.Fatalf("Expected overlap to be %t for %s and %s", tc.Overlap, tc.A, tc.B)
+		}
+	}
+}
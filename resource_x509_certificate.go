@@ -0,0 +1,168 @@
+package main
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"io/ioutil"
+	"log"
+)
+
+type X509Certificate struct {
+	MonthsUntilExpiration int         `json:"monthsUntilExpiration,omitempty"`
+	Id                    json.Number `json:"_id,omitempty"`
+	CreatedAt             string      `json:"createdAt,omitempty"`
+	NotAfter              string      `json:"notAfter,omitempty"`
+	Subject               string      `json:"subject,omitempty"`
+}
+
+// mongoatlas_x509_certificate generates an Atlas managed certificate for a
+// user with x509Type MANAGED. Atlas cannot revoke a single certificate, so
+// destroying the resource only forgets it, it stays valid until notAfter.
+func resourceX509Certificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceX509CertificateCreate,
+		Read:   resourceX509CertificateRead,
+		Delete: resourceX509CertificateDelete,
+		Schema: map[string]*schema.Schema{
+			"groupId": groupIdSchema(),
+			"username": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"monthsUntilExpiration": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      3,
+				ValidateFunc: validateMonthsUntilExpiration,
+			},
+			// certificate and private key, as returned by Atlas
+			"certificate": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"subject": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"createdAt": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"notAfter": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// x509CertificateSerial returns the serial number of the certificate in a PEM
+// bundle, Atlas lists the certificates of a user by that serial number
+func x509CertificateSerial(bundle []byte) (string, error) {
+	for {
+		var block *pem.Block
+		block, bundle = pem.Decode(bundle)
+		if block == nil {
+			return "", fmt.Errorf("No certificate found in the PEM returned by Atlas")
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return "", err
+		}
+		return certificate.SerialNumber.String(), nil
+	}
+}
+
+func resourceX509CertificateCreate(d *schema.ResourceData, m interface{}) error {
+	// Instantiate the MongoatlasClient. Source in client.go, it handles all the HTTP REST API communication
+	client := m.(*MongoatlasClient)
+
+	certificate := &X509Certificate{
+		MonthsUntilExpiration: d.Get("monthsUntilExpiration").(int),
+	}
+
+	var jsonbuffer []byte
+
+	jsonpayload := bytes.NewBuffer(jsonbuffer)
+	enc := json.NewEncoder(jsonpayload)
+	enc.Encode(certificate)
+
+	log.Printf("Sending %s \n", jsonpayload)
+
+	// communication with API commence here
+	certificate_req, err := client.Post(fmt.Sprintf("groups/%s/databaseUsers/%s/certs",
+		d.Get("groupId").(string),
+		d.Get("username").(string),
+	), jsonpayload)
+	if err != nil {
+		return err
+	}
+
+	body, err := ioutil.ReadAll(certificate_req.Body)
+	if err != nil {
+		return err
+	}
+
+	if certificate_req.StatusCode != 200 && certificate_req.StatusCode != 201 {
+		return fmt.Errorf("Failed to create X.509 certificate. Got the following response body %s", string(body))
+	}
+
+	// the body holds the private key, it is not logged
+	serial, err := x509CertificateSerial(body)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(serial)
+	d.Set("certificate", string(body))
+
+	return resourceX509CertificateRead(d, m)
+}
+
+func resourceX509CertificateRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*MongoatlasClient)
+
+	results, err := client.GetAll(fmt.Sprintf("groups/%s/databaseUsers/%s/certs",
+		d.Get("groupId").(string),
+		d.Get("username").(string),
+	))
+	if err != nil {
+		return err
+	}
+
+	for _, raw := range results {
+		var certificate X509Certificate
+		if err := json.Unmarshal(raw, &certificate); err != nil {
+			return err
+		}
+
+		if certificate.Id.String() != d.Id() {
+			continue
+		}
+
+		d.Set("subject", certificate.Subject)
+		d.Set("createdAt", certificate.CreatedAt)
+		d.Set("notAfter", certificate.NotAfter)
+		return nil
+	}
+
+	log.Printf("[DEBUG] X.509 certificate %s no longer exist, so we'll drop it from the state", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceX509CertificateDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] X.509 certificate %s stays valid until it expires", d.Id())
+	return nil
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccMongoatlasX509Certificate_basic(t *testing.T) {
+	testGroupId := os.Getenv("MONGOATLAS_GROUPID")
+
+	testAccMongoatlasX509CertificateConfig := fmt.Sprintf(
+		`resource "mongoatlas_database_user" "acceptancetest_x509user" {
+			databaseName = "$external"
+			username = "CN=acctest"
+			x509Type = "MANAGED"
+			roles = [
+				{
+					databaseName = "admin"
+					roleName = "read"
+				}
+			]
+			groupId = "%s"
+		}
+
+		resource "mongoatlas_x509_certificate" "acceptancetest_certificate" {
+			groupId = "${mongoatlas_database_user.acceptancetest_x509user.groupId}"
+			username = "${mongoatlas_database_user.acceptancetest_x509user.username}"
+			monthsUntilExpiration = 1
+		}
+	`, testGroupId)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoatlasX509CertificateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccMongoatlasX509CertificateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoatlasX509CertificateExists("mongoatlas_x509_certificate.acceptancetest_certificate"),
+					resource.TestCheckResourceAttrSet(
+						"mongoatlas_x509_certificate.acceptancetest_certificate", "certificate"),
+					resource.TestCheckResourceAttrSet(
+						"mongoatlas_x509_certificate.acceptancetest_certificate", "notAfter"),
+				),
+			},
+		},
+	})
+
+}
+
+// certificates cannot be deleted on their own, the user going away is enough
+func testAccCheckMongoatlasX509CertificateDestroy(s *terraform.State) error {
+	return testAccCheckMongoatlasDatabaseUserDestroy(s)
+}
+
+func testAccCheckMongoatlasX509CertificateExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No X.509 certificate ID is set")
+		}
+		return nil
+	}
+}
+
+func TestAccMongoAtlasX509CertificateSerial(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1234567890),
+		Subject:      pkix.Name{CommonName: "acctest"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Atlas returns the certificate followed by its private key
+	bundle := append(
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})...,
+	)
+
+	serial, err := x509CertificateSerial(bundle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if serial != "1234567890" {
+		t.Fatalf("Expected serial 1234567890, Got %s", serial)
+	}
+
+	if _, err := x509CertificateSerial(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})); err == nil {
+		t.Fatalf("Expected an error for a PEM without certificate")
+	}
+}
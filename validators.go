@@ -1,8 +1,11 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
+	"net"
 	"strings"
+	"time"
 )
 
 func validateDiskSizeGB(v interface{}, k string) (ws []string, errors []error) {
@@ -88,6 +91,251 @@ func validateRoleName(v interface{}, k string) (ws []string, errors []error) {
 
 }
 
+func validateNotificationTypeName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if !stringInSlice(value, []string{"EMAIL", "SMS", "SLACK", "PAGER_DUTY", "WEBHOOK", "GROUP", "USER", "TEAM"}) {
+		errors = append(errors, fmt.Errorf(
+			"%q is invalid. Valid values are EMAIL, SMS, SLACK, PAGER_DUTY, WEBHOOK, GROUP, USER, TEAM",
+			k))
+		return
+	}
+	return
+}
+
+func validateMatcherOperator(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if !stringInSlice(value, []string{"EQUALS", "NOT_EQUALS", "CONTAINS", "NOT_CONTAINS", "STARTS_WITH", "ENDS_WITH", "REGEX"}) {
+		errors = append(errors, fmt.Errorf(
+			"%q is invalid. Valid values are EQUALS, NOT_EQUALS, CONTAINS, NOT_CONTAINS, STARTS_WITH, ENDS_WITH, REGEX",
+			k))
+		return
+	}
+	return
+}
+
+func validateThresholdOperator(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if value != "GREATER_THAN" && value != "LESS_THAN" {
+		errors = append(errors, fmt.Errorf(
+			"%q must be GREATER_THAN or LESS_THAN",
+			k))
+		return
+	}
+	return
+}
+
+func validateAlertStatus(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if !stringInSlice(value, []string{"OPEN", "TRACKING", "CLOSED"}) {
+		errors = append(errors, fmt.Errorf(
+			"%q is invalid. Valid values are OPEN, TRACKING, CLOSED",
+			k))
+		return
+	}
+	return
+}
+
+func validateRFC3339Time(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if _, err := time.Parse(time.RFC3339, value); err != nil {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a RFC3339 timestamp such as 2018-01-01T00:00:00Z: %s",
+			k, err))
+		return
+	}
+	return
+}
+
+func validateIntegrationType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if !stringInSlice(value, []string{"SLACK", "PAGER_DUTY", "DATADOG", "WEBHOOK"}) {
+		errors = append(errors, fmt.Errorf(
+			"%q is invalid. Valid values are SLACK, PAGER_DUTY, DATADOG, WEBHOOK",
+			k))
+		return
+	}
+	return
+}
+
+func validateDayOfWeek(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+	if value < 1 || value > 7 {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a value between 1 (Sunday) and 7 (Saturday)",
+			k))
+		return
+	}
+	return
+}
+
+func validateHourOfDay(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+	if value < 0 || value > 23 {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a value between 0 and 23",
+			k))
+		return
+	}
+	return
+}
+
+func validateJsonString(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	var document map[string]interface{}
+	if err := json.Unmarshal([]byte(value), &document); err != nil {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a JSON document: %s",
+			k, err))
+		return
+	}
+	return
+}
+
+func validateEncryptionAtRestProvider(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if !stringInSlice(value, []string{"NONE", "AWS", "AZURE", "GCP"}) {
+		errors = append(errors, fmt.Errorf(
+			"%q is invalid. Valid values are NONE, AWS, AZURE, GCP",
+			k))
+		return
+	}
+	return
+}
+
+func validateX509Type(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if !stringInSlice(value, []string{"NONE", "MANAGED", "CUSTOMER"}) {
+		errors = append(errors, fmt.Errorf(
+			"%q is invalid. Valid values are NONE, MANAGED, CUSTOMER",
+			k))
+		return
+	}
+	return
+}
+
+func validateLdapAuthType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if !stringInSlice(value, []string{"NONE", "USER", "GROUP"}) {
+		errors = append(errors, fmt.Errorf(
+			"%q is invalid. Valid values are NONE, USER, GROUP",
+			k))
+		return
+	}
+	return
+}
+
+func validateAwsIAMType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if !stringInSlice(value, []string{"NONE", "USER", "ROLE"}) {
+		errors = append(errors, fmt.Errorf(
+			"%q is invalid. Valid values are NONE, USER, ROLE",
+			k))
+		return
+	}
+	return
+}
+
+func validateScopeType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if !stringInSlice(value, []string{"CLUSTER", "DATA_LAKE"}) {
+		errors = append(errors, fmt.Errorf(
+			"%q is invalid. Valid values are CLUSTER, DATA_LAKE",
+			k))
+		return
+	}
+	return
+}
+
+func validateMonthsUntilExpiration(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+
+	if value < 1 || value > 24 {
+		errors = append(errors, fmt.Errorf(
+			"%q must be between 1 and 24 months",
+			k))
+		return
+	}
+	return
+}
+
+func validateCidrBlock(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	ip, network, err := net.ParseCIDR(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a CIDR block, e.g. 10.0.0.0/24: %s",
+			k, err))
+		return
+	}
+
+	if !ip.Equal(network.IP) {
+		errors = append(errors, fmt.Errorf(
+			"%q has host bits set, the network address is %s",
+			k, network))
+		return
+	}
+	return
+}
+
+// validateAtlasCidrBlock checks the limits Atlas puts on a container CIDR
+// block: a private range between /18 and /21
+func validateAtlasCidrBlock(v interface{}, k string) (ws []string, errors []error) {
+	ws, errors = validateCidrBlock(v, k)
+	if len(errors) > 0 {
+		return
+	}
+
+	_, network, _ := net.ParseCIDR(v.(string))
+
+	ones, bits := network.Mask.Size()
+	if bits != 32 || ones < 18 || ones > 21 {
+		errors = append(errors, fmt.Errorf(
+			"%q must be an IPv4 block between /18 and /21",
+			k))
+		return
+	}
+
+	private := false
+	for _, block := range []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16"} {
+		_, rfc1918, _ := net.ParseCIDR(block)
+		if rfc1918.Contains(network.IP) {
+			private = true
+		}
+	}
+	if !private {
+		errors = append(errors, fmt.Errorf(
+			"%q must be within the RFC1918 private ranges 10.0.0.0/8, 172.16.0.0/12 or 192.168.0.0/16",
+			k))
+		return
+	}
+	return
+}
+
+func cidrBlocksOverlap(a string, b string) bool {
+	_, networkA, err := net.ParseCIDR(a)
+	if err != nil {
+		return false
+	}
+	_, networkB, err := net.ParseCIDR(b)
+	if err != nil {
+		return false
+	}
+	return networkA.Contains(networkB.IP) || networkB.Contains(networkA.IP)
+}
+
 func stringInSlice(a string, list []string) bool {
 	for _, b := range list {
 		if b == a {